@@ -183,6 +183,22 @@ func (c *ClusterConfig) ApplyContext(ctxConf *Config, contextName *string) {
 	}
 }
 
+// LoadContext loads the configuration of the cluster of the named context in the config file,
+// which allows a command to connect to another cluster than the current context
+func LoadContext(name string) (*ClusterConfig, error) {
+	ctxConf, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	if ctxConf == nil || ctxConf.Contexts[name] == nil {
+		return nil, fmt.Errorf("the context %s does not exist", name)
+	}
+
+	config := ClusterConfig{}
+	config.ApplyContext(ctxConf, &name)
+	return &config, nil
+}
+
 func (c *ClusterConfig) Client(version config.APIVersion) Client {
 	if len(c.Token) > 0 && len(c.TokenFile) > 0 {
 		logger.Critical("the token and token file can not be specified at the same time")
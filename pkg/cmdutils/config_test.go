@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmdutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadContext(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	_, err := LoadContext("dr")
+	assert.NotNil(t, err)
+	assert.Equal(t, "the context dr does not exist", err.Error())
+
+	configDir := filepath.Join(home, ".config", "pulsar")
+	assert.Nil(t, os.MkdirAll(configDir, 0755))
+	content := `
+auth-info:
+  dr:
+    token: dr-token
+contexts:
+  dr:
+    admin-service-url: http://dr:8080
+current-context: dr
+`
+	assert.Nil(t, os.WriteFile(filepath.Join(configDir, "config"), []byte(content), 0600))
+
+	cfg, err := LoadContext("dr")
+	assert.Nil(t, err)
+	assert.Equal(t, "http://dr:8080", cfg.WebServiceURL)
+	assert.Equal(t, "dr-token", cfg.Token)
+
+	_, err = LoadContext("other")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmdutils
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsar/auth"
+	"github.com/apache/pulsar-client-go/pulsar/log"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+)
+
+// AddServiceURLFlag registers the flag of the service url used by the commands which
+// produce or consume messages
+func AddServiceURLFlag(set *pflag.FlagSet, serviceURL *string) {
+	set.StringVar(serviceURL, "service-url", "",
+		"The Pulsar service url used to produce or consume messages, e.g. pulsar://localhost:6650. "+
+			"Defaults to the admin service url")
+}
+
+// DataClient creates a client of the Pulsar messaging API. The admin web service url is
+// used to look up the topics if the service url is empty.
+func (c *ClusterConfig) DataClient(serviceURL string) (pulsar.Client, error) {
+	if len(c.Token) > 0 && len(c.TokenFile) > 0 {
+		return nil, errors.New("the token and token file can not be specified at the same time")
+	}
+
+	opts := pulsar.ClientOptions{
+		URL:                        serviceURL,
+		TLSTrustCertsFilePath:      c.TLSTrustCertsFilePath,
+		TLSAllowInsecureConnection: c.TLSAllowInsecureConnection,
+		TLSValidateHostname:        c.TLSEnableHostnameVerification,
+	}
+	if len(opts.URL) == 0 {
+		opts.URL = c.WebServiceURL
+	}
+	if logger.Level < 4 {
+		opts.Logger = log.DefaultNopLogger()
+	}
+
+	switch {
+	case len(c.KeyFile) > 0:
+		opts.Authentication = pulsar.NewAuthenticationOAuth2(map[string]string{
+			auth.ConfigParamType:      auth.ConfigParamTypeClientCredentials,
+			auth.ConfigParamIssuerURL: c.IssuerEndpoint,
+			auth.ConfigParamAudience:  c.Audience,
+			auth.ConfigParamScope:     c.Scope,
+			auth.ConfigParamKeyFile:   c.KeyFile,
+			auth.ConfigParamClientID:  c.ClientID,
+		})
+	case len(c.AuthPlugin) > 0:
+		authentication, err := pulsar.NewAuthentication(c.AuthPlugin, authParamsToJSON(c.AuthParams))
+		if err != nil {
+			return nil, err
+		}
+		opts.Authentication = authentication
+	case len(c.TLSCertFile) > 0 && len(c.TLSKeyFile) > 0:
+		opts.Authentication = pulsar.NewAuthenticationTLS(c.TLSCertFile, c.TLSKeyFile)
+	case len(c.Token) > 0:
+		opts.Authentication = pulsar.NewAuthenticationToken(c.Token)
+	case len(c.TokenFile) > 0:
+		opts.Authentication = pulsar.NewAuthenticationTokenFromFile(c.TokenFile)
+	}
+
+	return pulsar.NewClient(opts)
+}
+
+func NewDataClient(serviceURL string) (pulsar.Client, error) {
+	return PulsarCtlConfig.DataClient(serviceURL)
+}
+
+// authParamsToJSON converts the "key1:val1,key2:val2" form of the auth params to the
+// JSON form accepted by the messaging client
+func authParamsToJSON(params string) string {
+	if json.Valid([]byte(params)) {
+		return params
+	}
+
+	m := make(map[string]string)
+	for _, kv := range strings.Split(params, ",") {
+		if k, v, ok := strings.Cut(kv, ":"); ok {
+			m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	b, _ := json.Marshal(m)
+	return string(b)
+}
@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmdutils
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+)
+
+// TopicPartitions returns the partitions of a partitioned topic, or the topic itself if
+// it is a non-partitioned topic or a partition
+func TopicPartitions(topics admin.Topics, topic utils.TopicName) ([]utils.TopicName, error) {
+	if topic.GetPartitionIndex() >= 0 {
+		return []utils.TopicName{topic}, nil
+	}
+
+	meta, err := topics.GetMetadata(topic)
+	if err != nil {
+		return nil, err
+	}
+	if meta.Partitions == 0 {
+		return []utils.TopicName{topic}, nil
+	}
+
+	partitions := make([]utils.TopicName, 0, meta.Partitions)
+	for i := 0; i < meta.Partitions; i++ {
+		p, err := topic.GetPartition(i)
+		if err != nil {
+			return nil, err
+		}
+		partitions = append(partitions, *p)
+	}
+	return partitions, nil
+}
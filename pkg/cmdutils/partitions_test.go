@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmdutils
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTopics struct {
+	admin.Topics
+	partitions int
+}
+
+func (f *fakeTopics) GetMetadata(utils.TopicName) (utils.PartitionedTopicMetadata, error) {
+	return utils.PartitionedTopicMetadata{Partitions: f.partitions}, nil
+}
+
+func TestTopicPartitions(t *testing.T) {
+	topic, err := utils.GetTopicName("test-topic-partitions")
+	assert.Nil(t, err)
+
+	partitions, err := TopicPartitions(&fakeTopics{partitions: 2}, *topic)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(partitions))
+	assert.Equal(t, "persistent://public/default/test-topic-partitions-partition-1", partitions[1].String())
+
+	partitions, err = TopicPartitions(&fakeTopics{}, *topic)
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.TopicName{*topic}, partitions)
+
+	// a partition is not looked up
+	partition, err := topic.GetPartition(0)
+	assert.Nil(t, err)
+	partitions, err = TopicPartitions(nil, *partition)
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.TopicName{*partition}, partitions)
+}
@@ -0,0 +1,83 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmdutils
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin/auth"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin/config"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/kris-nova/logger"
+)
+
+// RestClient is used to call the admin REST endpoints which are not covered by the admin client
+type RestClient struct {
+	*rest.Client
+	APIVersion config.APIVersion
+}
+
+// Endpoint builds the path of an admin REST endpoint, each part is escaped
+func (c *RestClient) Endpoint(componentPath string, parts ...string) string {
+	escapedParts := make([]string, len(parts))
+	for i, part := range parts {
+		escapedParts[i] = url.PathEscape(part)
+	}
+	return path.Join(
+		utils.MakeHTTPPath(c.APIVersion.String(), componentPath),
+		path.Join(escapedParts...),
+	)
+}
+
+func (c *ClusterConfig) RestClient(version config.APIVersion) *RestClient {
+	cfg := config.Config(*c)
+	if len(cfg.WebServiceURL) == 0 {
+		cfg.WebServiceURL = admin.DefaultWebServiceURL
+	}
+
+	authProvider, err := auth.GetAuthProvider(&cfg)
+	if err != nil {
+		logger.Critical("client error: %s", err.Error())
+		os.Exit(1)
+	}
+
+	return &RestClient{
+		Client: &rest.Client{
+			ServiceURL:  cfg.WebServiceURL,
+			VersionInfo: admin.ReleaseVersion,
+			HTTPClient: &http.Client{
+				Timeout:   admin.DefaultHTTPTimeOutDuration,
+				Transport: authProvider,
+			},
+		},
+		APIVersion: version,
+	}
+}
+
+func NewPulsarRestClient() *RestClient {
+	return PulsarCtlConfig.RestClient(config.V2)
+}
+
+func NewPulsarRestClientWithAPIVersion(version config.APIVersion) *RestClient {
+	return PulsarCtlConfig.RestClient(version)
+}
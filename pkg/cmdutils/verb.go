@@ -38,6 +38,12 @@ type VerbCmd struct {
 
 // AddVerbCmd create a registers a new command under the given resource command
 func AddVerbCmd(flagGrouping *FlagGrouping, parentResourceCmd *cobra.Command, newVerbCmd func(*VerbCmd)) {
+	parentResourceCmd.AddCommand(NewVerbCmd(flagGrouping, newVerbCmd))
+}
+
+// NewVerbCmd creates a new command without registering it, which allows a verb command
+// to have its own verb commands
+func NewVerbCmd(flagGrouping *FlagGrouping, newVerbCmd func(*VerbCmd)) *cobra.Command {
 	verb := &VerbCmd{
 		Command: &cobra.Command{},
 	}
@@ -53,7 +59,7 @@ func AddVerbCmd(flagGrouping *FlagGrouping, parentResourceCmd *cobra.Command, ne
 	}
 	verb.FlagSetGroup.AddTo(verb.Command)
 
-	parentResourceCmd.AddCommand(verb.Command)
+	return verb.Command
 }
 
 func AddVerbCmds(flagGrouping *FlagGrouping, parentResourceCmd *cobra.Command, newVerbCmd ...func(cmd *VerbCmd)) {
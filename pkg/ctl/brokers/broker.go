@@ -38,6 +38,7 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, getInternalConfigCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, getRuntimeConfigCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, healthCheckCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, drainCmd)
 
 	return resourceCmd
 }
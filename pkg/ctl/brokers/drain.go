@@ -0,0 +1,277 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokers
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+// ownershipPollInterval is the interval to check whether a bundle has a new owner
+var ownershipPollInterval = 500 * time.Millisecond
+
+type drainArgs struct {
+	cluster  string
+	to       string
+	interval time.Duration
+	timeout  time.Duration
+}
+
+func drainCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Move all the bundles owned by a broker to other brokers, which is used before " +
+		"stopping the broker for maintenance. The bundles are unloaded one by one and assigned to the " +
+		"destination broker, or to the broker chosen by the load manager if no destination is specified. " +
+		"After unloading a bundle, the command waits until another broker owns the bundle, a bundle " +
+		"which is assigned back to the broker is reported as failed. " +
+		"The heartbeat namespaces of the broker are not moved."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	drain := cmdutils.Example{
+		Desc:    "Move the bundles owned by the broker (broker) to other brokers chosen by the load manager",
+		Command: "pulsarctl brokers drain (broker)",
+	}
+
+	drainTo := cmdutils.Example{
+		Desc:    "Move the bundles owned by the broker (broker) to the broker (destination), one bundle every 5 seconds",
+		Command: "pulsarctl brokers drain (broker) --to (destination) --interval 5s",
+	}
+	examples = append(examples, drain, drainTo)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "[1/2] Moved the bundle public/default/0x00000000_0x80000000 from the broker (broker) " +
+			"to the broker (new-owner)\n" +
+			"[2/2] Moved the bundle public/default/0x80000000_0xffffffff from the broker (broker) " +
+			"to the broker (new-owner)\n" +
+			"Drained 2 of 2 bundles from the broker (broker)",
+	}
+
+	argsError := cmdutils.Output{
+		Desc: "the broker is not specified or the broker is specified more than one",
+		Out:  "[✖]  the broker is not specified or the broker is specified more than one",
+	}
+
+	clusterError := cmdutils.Output{
+		Desc: "there are multiple clusters and the cluster of the broker is not specified",
+		Out:  "[✖]  there are multiple clusters, the cluster of the broker should be specified with --cluster",
+	}
+
+	failedOut := cmdutils.Output{
+		Desc: "some of the bundles failed to be moved",
+		Out:  "[✖]  failed to move (failed) of (total) bundles",
+	}
+	out = append(out, successOut, argsError, clusterError, failedOut)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"drain",
+		"Move all the bundles owned by a broker to other brokers",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"drain")
+
+	args := drainArgs{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doDrain(vc, &args)
+	}, "the broker is not specified or the broker is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Drain", func(set *pflag.FlagSet) {
+		set.StringVarP(&args.cluster, "cluster", "c", "",
+			"the cluster of the broker, it can be omitted if there is only one cluster")
+		set.StringVar(&args.to, "to", "",
+			"the broker to move the bundles to, the load manager chooses the brokers if it is not specified")
+		set.DurationVarP(&args.interval, "interval", "i", time.Second,
+			"the time to wait between moving two bundles")
+		set.DurationVar(&args.timeout, "timeout", 30*time.Second,
+			"the time to wait for another broker to own a bundle")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doDrain(vc *cmdutils.VerbCmd, args *drainArgs) error {
+	broker := vc.NameArg
+	if broker == "" {
+		return errors.New("should specified a broker")
+	}
+	if args.to == broker {
+		return errors.New("the destination broker should not be the broker to drain")
+	}
+	if args.interval < 0 || args.timeout <= 0 {
+		return errors.New("the interval can not be negative and the timeout should be positive")
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	cluster, err := resolveCluster(admin, args.cluster)
+	if err != nil {
+		return err
+	}
+
+	owned, err := admin.Brokers().GetOwnedNamespaces(cluster, broker)
+	if err != nil {
+		return err
+	}
+	bundles := drainableBundles(owned, broker)
+	if len(bundles) == 0 {
+		vc.Command.Printf("The broker %s does not own any bundles to move\n", broker)
+		return nil
+	}
+
+	// the owner is looked up among the draining broker and the destination if it is specified,
+	// otherwise among all the active brokers of the cluster
+	bundleOwner := func(bundle string) (string, error) {
+		candidates := []string{broker, args.to}
+		if args.to == "" {
+			active, err := admin.Brokers().GetActiveBrokers(cluster)
+			if err != nil {
+				return "", err
+			}
+			candidates = append([]string{broker}, active...)
+		}
+		for _, b := range candidates {
+			owned, err := admin.Brokers().GetOwnedNamespaces(cluster, b)
+			if err != nil {
+				return "", err
+			}
+			if _, ok := owned[bundle]; ok {
+				return b, nil
+			}
+		}
+		return "", nil
+	}
+
+	failed := 0
+	for i, bundle := range bundles {
+		if i > 0 {
+			time.Sleep(args.interval)
+		}
+		owner, err := moveBundle(bundle, broker, args.to, args.timeout, bundleOwner)
+		if err != nil {
+			failed++
+			vc.Command.Printf("[%d/%d] Failed to move the bundle %s: %v\n", i+1, len(bundles), bundle, err)
+			continue
+		}
+		vc.Command.Printf("[%d/%d] Moved the bundle %s from the broker %s to the broker %s\n",
+			i+1, len(bundles), bundle, broker, owner)
+	}
+	vc.Command.Printf("Drained %d of %d bundles from the broker %s\n", len(bundles)-failed, len(bundles), broker)
+
+	if failed > 0 {
+		return errors.Errorf("failed to move %d of %d bundles", failed, len(bundles))
+	}
+	return nil
+}
+
+// resolveCluster returns the specified cluster, or the only cluster if it is not specified
+func resolveCluster(admin cmdutils.Client, cluster string) (string, error) {
+	if cluster != "" {
+		return cluster, nil
+	}
+	clusters, err := admin.Clusters().List()
+	if err != nil {
+		return "", err
+	}
+	if len(clusters) != 1 {
+		return "", errors.New("there are multiple clusters, the cluster of the broker should be specified with --cluster")
+	}
+	return clusters[0], nil
+}
+
+// drainableBundles returns the bundles owned by a broker except the heartbeat namespaces
+// of the broker, which are named pulsar/<cluster>/<broker> or pulsar/<broker>
+func drainableBundles(owned map[string]utils.NamespaceOwnershipStatus, broker string) []string {
+	var bundles []string
+	for bundle := range owned {
+		ns := bundle
+		if i := strings.LastIndex(bundle, "/"); i >= 0 {
+			ns = bundle[:i]
+		}
+		if strings.HasPrefix(ns, "pulsar/") && strings.HasSuffix(ns, "/"+broker) {
+			continue
+		}
+		bundles = append(bundles, bundle)
+	}
+	sort.Strings(bundles)
+	return bundles
+}
+
+// moveBundle unloads a bundle from the broker and returns the broker which owns the bundle afterwards
+func moveBundle(name, broker, to string, timeout time.Duration,
+	bundleOwner func(string) (string, error)) (string, error) {
+	ns, bundle, err := ctlutils.ParseBundleName(name)
+	if err != nil {
+		return "", err
+	}
+	if err := unloadBundle(ns.String(), bundle, to); err != nil {
+		return "", err
+	}
+	return waitForNewOwner(name, broker, timeout, bundleOwner)
+}
+
+// unloadBundle unloads a bundle of a namespace, the bundle is assigned to the destination
+// broker if it is specified, otherwise the load manager chooses the new owner
+func unloadBundle(ns, bundle, destination string) error {
+	var params map[string]string
+	if destination != "" {
+		params = map[string]string{"destinationBroker": destination}
+	}
+	rc := cmdutils.NewPulsarRestClient()
+	return rc.PutWithQueryParams(rc.Endpoint("/namespaces", ns, bundle, "unload"), nil, nil, params)
+}
+
+// waitForNewOwner waits until a broker other than the draining broker owns a bundle. The draining
+// broker may still list the bundle for a while after the unload, but once it has released the bundle,
+// owning it again means the bundle was assigned back to it.
+func waitForNewOwner(name, broker string, timeout time.Duration,
+	bundleOwner func(string) (string, error)) (string, error) {
+	deadline := time.Now().Add(timeout)
+	released := false
+	for {
+		owner, err := bundleOwner(name)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case owner == broker && released:
+			return "", errors.Errorf("the bundle was assigned back to the broker %s", broker)
+		case owner == "":
+			released = true
+		case owner != broker:
+			return owner, nil
+		}
+		if time.Now().After(deadline) {
+			if released {
+				return "", errors.Errorf("no broker owns the bundle after %s", timeout)
+			}
+			return "", errors.Errorf("the bundle is still owned by the broker after %s", timeout)
+		}
+		time.Sleep(ownershipPollInterval)
+	}
+}
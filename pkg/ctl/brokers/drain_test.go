@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainableBundles(t *testing.T) {
+	owned := map[string]utils.NamespaceOwnershipStatus{
+		"public/default/0x80000000_0xffffffff":                   {},
+		"public/default/0x00000000_0x80000000":                   {},
+		"pulsar/standalone/127.0.0.1:8080/0x00000000_0xffffffff": {},
+		"pulsar/127.0.0.1:8080/0x00000000_0xffffffff":            {},
+		"pulsar/system/0x00000000_0xffffffff":                    {},
+	}
+
+	assert.Equal(t, []string{
+		"public/default/0x00000000_0x80000000",
+		"public/default/0x80000000_0xffffffff",
+		"pulsar/system/0x00000000_0xffffffff",
+	}, drainableBundles(owned, "127.0.0.1:8080"))
+}
+
+func TestWaitForNewOwner(t *testing.T) {
+	pollInterval := ownershipPollInterval
+	ownershipPollInterval = time.Millisecond
+	defer func() {
+		ownershipPollInterval = pollInterval
+	}()
+
+	bundle := "public/default/0x00000000_0xffffffff"
+	broker := "127.0.0.1:8080"
+	ownerSequence := func(owners ...string) func(string) (string, error) {
+		checks := 0
+		return func(string) (string, error) {
+			owner := owners[len(owners)-1]
+			if checks < len(owners) {
+				owner = owners[checks]
+			}
+			checks++
+			return owner, nil
+		}
+	}
+
+	owner, err := waitForNewOwner(bundle, broker, time.Second,
+		ownerSequence(broker, "", "127.0.0.1:8081"))
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:8081", owner)
+
+	owner, err = waitForNewOwner(bundle, broker, time.Second,
+		ownerSequence(broker, "127.0.0.1:8081"))
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:8081", owner)
+
+	_, err = waitForNewOwner(bundle, broker, time.Second, ownerSequence(broker, "", broker))
+	assert.EqualError(t, err, "the bundle was assigned back to the broker 127.0.0.1:8080")
+
+	_, err = waitForNewOwner(bundle, broker, 10*time.Millisecond, ownerSequence(broker))
+	assert.EqualError(t, err, "the bundle is still owned by the broker after 10ms")
+
+	_, err = waitForNewOwner(bundle, broker, 10*time.Millisecond, ownerSequence(""))
+	assert.EqualError(t, err, "no broker owns the bundle after 10ms")
+
+	_, err = waitForNewOwner(bundle, broker, time.Second,
+		func(string) (string, error) {
+			return "", errors.New("broker is not available")
+		})
+	assert.EqualError(t, err, "broker is not available")
+}
+
+func TestDrainWithInvalidArgs(t *testing.T) {
+	args := []string{"drain", "127.0.0.1:8080", "--to", "127.0.0.1:8080"}
+	_, execErr, _, _ := TestBrokersCommands(drainCmd, args)
+	assert.EqualError(t, execErr, "the destination broker should not be the broker to drain")
+
+	args = []string{"drain", "127.0.0.1:8080", "--timeout", "0s"}
+	_, execErr, _, _ = TestBrokersCommands(drainCmd, args)
+	assert.EqualError(t, execErr, "the interval can not be negative and the timeout should be positive")
+
+	args = []string{"drain"}
+	_, _, nameErr, _ := TestBrokersCommands(drainCmd, args)
+	assert.EqualError(t, nameErr, "the broker is not specified or the broker is specified more than one")
+}
@@ -0,0 +1,211 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// bundleStatsConcurrency is the number of topics whose stats are fetched at the same time
+const bundleStatsConcurrency = 10
+
+func bundlesCommand(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	resourceCmd := cmdutils.NewVerbCmd(flagGrouping, BundlesCmd)
+
+	commands := []func(cmd *cmdutils.VerbCmd){
+		BundlesUnloadAllCmd,
+		BundlesSplitCmd,
+		BundlesStatsCmd,
+		BundlesMoveCmd,
+	}
+
+	cmdutils.AddVerbCmds(flagGrouping, resourceCmd, commands...)
+
+	return resourceCmd
+}
+
+// BundleStats is the stats of a namespace bundle, which is the sum of the stats of
+// the topics served by the bundle
+type BundleStats struct {
+	Bundle           string  `json:"bundle"`
+	Owner            string  `json:"owner,omitempty"`
+	Topics           int     `json:"topics"`
+	Producers        int     `json:"producers"`
+	Subscriptions    int     `json:"subscriptions"`
+	Consumers        int     `json:"consumers"`
+	MsgRateIn        float64 `json:"msgRateIn"`
+	MsgRateOut       float64 `json:"msgRateOut"`
+	MsgThroughputIn  float64 `json:"msgThroughputIn"`
+	MsgThroughputOut float64 `json:"msgThroughputOut"`
+	StorageSize      int64   `json:"storageSize"`
+	BacklogSize      int64   `json:"backlogSize"`
+}
+
+func (b *BundleStats) add(stats *utils.TopicStats) {
+	b.Topics++
+	b.Producers += len(stats.Publishers)
+	b.Subscriptions += len(stats.Subscriptions)
+	for _, sub := range stats.Subscriptions {
+		b.Consumers += len(sub.Consumers)
+	}
+	b.MsgRateIn += stats.MsgRateIn
+	b.MsgRateOut += stats.MsgRateOut
+	b.MsgThroughputIn += stats.MsgThroughputIn
+	b.MsgThroughputOut += stats.MsgThroughputOut
+	b.StorageSize += stats.StorageSize
+	b.BacklogSize += stats.BacklogSize
+}
+
+// bundleRanges returns the ranges of the bundles divided by the boundaries,
+// in the form of {start-boundary}_{end-boundary}
+func bundleRanges(boundaries []string) []string {
+	if len(boundaries) < 2 {
+		return nil
+	}
+	ranges := make([]string, 0, len(boundaries)-1)
+	for i := 0; i < len(boundaries)-1; i++ {
+		ranges = append(ranges, boundaries[i]+"_"+boundaries[i+1])
+	}
+	return ranges
+}
+
+// getBundleRanges returns the ranges of the bundles of a namespace
+func getBundleRanges(admin cmdutils.Client, ns *utils.NameSpaceName) ([]string, error) {
+	policies, err := admin.Namespaces().GetPolicies(ns.String())
+	if err != nil {
+		return nil, err
+	}
+	if policies.Bundles == nil {
+		return nil, errors.Errorf("the namespace %s has no bundles", ns.String())
+	}
+	return bundleRanges(policies.Bundles.Boundaries), nil
+}
+
+type topicBundle struct {
+	topic  *utils.TopicName
+	bundle string
+	stats  utils.TopicStats
+}
+
+// collectBundleStats sums up the stats of the topics of a namespace by bundle, the owner
+// of a bundle is looked up through one of its topics so it is left empty if the bundle
+// has no topics
+func collectBundleStats(admin cmdutils.Client, ns *utils.NameSpaceName) ([]BundleStats, error) {
+	ranges, err := getBundleRanges(admin, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := admin.Namespaces().GetTopics(ns.String())
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := getTopicBundles(admin, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make(map[string]*BundleStats, len(ranges))
+	for _, r := range ranges {
+		bundles[r] = &BundleStats{Bundle: r}
+	}
+	// topics in a bundle are looked up in order, so the owner is stable between runs
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].topic.String() < results[j].topic.String()
+	})
+	for i := range results {
+		r := &results[i]
+		b, ok := bundles[r.bundle]
+		if !ok {
+			// the bundles were split after the policies were read
+			b = &BundleStats{Bundle: r.bundle}
+			bundles[r.bundle] = b
+		}
+		if b.Topics == 0 {
+			lookup, err := admin.Topics().Lookup(*r.topic)
+			if err != nil {
+				return nil, errors.Errorf("failed to look up the topic %s: %v", r.topic.String(), err)
+			}
+			b.Owner = lookup.HTTPURL
+			if b.Owner == "" {
+				b.Owner = lookup.HTTPURLTLS
+			}
+		}
+		b.add(&r.stats)
+	}
+
+	stats := make([]BundleStats, 0, len(bundles))
+	for _, b := range bundles {
+		stats = append(stats, *b)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Bundle < stats[j].Bundle
+	})
+	return stats, nil
+}
+
+// getTopicBundles gets the bundle and the stats of the topics concurrently
+func getTopicBundles(admin cmdutils.Client, topics []string) ([]topicBundle, error) {
+	results := make([]topicBundle, len(topics))
+	errs := make([]error, len(topics))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, bundleStatsConcurrency)
+	for i, t := range topics {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, t string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			topic, err := utils.GetTopicName(t)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			bundle, err := admin.Topics().GetBundleRange(*topic)
+			if err != nil {
+				errs[i] = errors.Errorf("failed to get the bundle of the topic %s: %v", t, err)
+				return
+			}
+			stats, err := admin.Topics().GetStats(*topic)
+			if err != nil {
+				errs[i] = errors.Errorf("failed to get the stats of the topic %s: %v", t, err)
+				return
+			}
+			results[i] = topicBundle{topic: topic, bundle: bundle, stats: stats}
+		}(i, t)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,99 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func BundlesCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the bundles of a namespace along with the broker " +
+		"owning each bundle, the number of topics and the message rate of the bundle. The owner is looked up " +
+		"through the topics of the bundle, so it is not shown for a bundle without topics."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	list := cmdutils.Example{
+		Desc:    "List the bundles of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces bundles (namespace-name)",
+	}
+	examples = append(examples, list)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-------------------------+-----------------------+--------+-------------+--------------+\n" +
+			"|         BUNDLE          |         OWNER         | TOPICS | MSG RATE IN | MSG RATE OUT |\n" +
+			"+-------------------------+-----------------------+--------+-------------+--------------+\n" +
+			"| 0x00000000_0x40000000   | http://localhost:8080 |      2 |       10.00 |        20.00 |\n" +
+			"| 0x40000000_0x80000000   |                       |      0 |        0.00 |         0.00 |\n" +
+			"+-------------------------+-----------------------+--------+-------------+--------------+",
+	}
+	out = append(out, successOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"bundles",
+		"Operations about the bundles of a namespace, or list the bundles of a namespace",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"bundle")
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doListBundles(vc)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doListBundles(vc *cmdutils.VerbCmd) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	stats, err := collectBundleStats(admin, ns)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(stats).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Bundle", "Owner", "Topics", "Msg Rate In", "Msg Rate Out"})
+			for _, b := range stats {
+				table.Append([]string{b.Bundle, b.Owner, strconv.Itoa(b.Topics),
+					fmt.Sprintf("%.2f", b.MsgRateIn), fmt.Sprintf("%.2f", b.MsgRateOut)})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func BundlesMoveCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for moving a bundle of a namespace to the specified broker, " +
+		"the bundle is unloaded from the current broker and assigned to the specified broker."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	move := cmdutils.Example{
+		Desc:    "Move the bundle 0x00000000_0x40000000 of the namespace public/default to the broker (broker)",
+		Command: "pulsarctl namespaces bundles move public/default/0x00000000_0x40000000 --to (broker)",
+	}
+	examples = append(examples, move)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully moved the bundle (bundle) of the namespace (namespace-name) to the broker (broker)",
+	}
+
+	argErr := cmdutils.Output{
+		Desc: "the bundle name is not specified or the bundle name is specified more than one",
+		Out:  "[✖]  the bundle name is not specified or the bundle name is specified more than one",
+	}
+
+	invalidNameErr := cmdutils.Output{
+		Desc: "the bundle name is not in the form of <tenant>/<namespace>/<bundle-range>",
+		Out: "[✖]  invalid bundle name (bundle-name), the bundle name should be in the form of " +
+			"<tenant>/<namespace>/<start-boundary>_<end-boundary>",
+	}
+	out = append(out, successOut, argErr, invalidNameErr, NsNotExistError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"move",
+		"Move a bundle of a namespace to a broker",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var to string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doMoveBundle(vc, to)
+	}, "the bundle name is not specified or the bundle name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Move", func(set *pflag.FlagSet) {
+		set.StringVar(&to, "to", "",
+			"the broker to move the bundle to, e.g. 127.0.0.1:8080")
+		_ = cobra.MarkFlagRequired(set, "to")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doMoveBundle(vc *cmdutils.VerbCmd, to string) error {
+	ns, bundle, err := ctlutils.ParseBundleName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.PutWithQueryParams(rc.Endpoint("/namespaces", ns.String(), bundle, "unload"), nil, nil,
+		map[string]string{"destinationBroker": to})
+	if err == nil {
+		vc.Command.Printf("Successfully moved the bundle %s of the namespace %s to the broker %s\n",
+			bundle, ns.String(), to)
+	}
+	return err
+}
@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+const (
+	rangeEquallyDivide       = "range_equally_divide"
+	topicCountEquallyDivide  = "topic_count_equally_divide"
+	specifiedPositionsDivide = "specified_positions_divide"
+)
+
+var splitAlgorithms = []string{rangeEquallyDivide, topicCountEquallyDivide, specifiedPositionsDivide}
+
+type splitBundleArgs struct {
+	bundle    string
+	algorithm string
+	positions []string
+	unload    bool
+}
+
+func BundlesSplitCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for splitting a bundle of a namespace. The bundle can be split " +
+		"into two bundles of the same hash range (range_equally_divide), two bundles having the same number " +
+		"of topics (topic_count_equally_divide), or at the specified positions (specified_positions_divide)."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	split := cmdutils.Example{
+		Desc:    "Split the bundle (bundle) of the namespace (namespace-name) into two bundles of the same hash range",
+		Command: "pulsarctl namespaces bundles split --bundle (bundle) (namespace-name)",
+	}
+
+	splitByTopics := cmdutils.Example{
+		Desc: "Split the bundle (bundle) of the namespace (namespace-name) into two bundles having the " +
+			"same number of topics, and unload the new bundles",
+		Command: "pulsarctl namespaces bundles split --bundle (bundle) " +
+			"--algorithm topic_count_equally_divide --unload (namespace-name)",
+	}
+
+	splitAtPositions := cmdutils.Example{
+		Desc: "Split the bundle 0x00000000_0xffffffff of the namespace (namespace-name) at the positions " +
+			"0x40000000 and 0x80000000",
+		Command: "pulsarctl namespaces bundles split --bundle 0x00000000_0xffffffff " +
+			"--algorithm specified_positions_divide --positions 0x40000000,0x80000000 (namespace-name)",
+	}
+	examples = append(examples, split, splitByTopics, splitAtPositions)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully split the bundle (bundle) of the namespace (namespace-name)",
+	}
+
+	algorithmOut := cmdutils.Output{
+		Desc: "the split algorithm is not supported",
+		Out: "[✖]  the split algorithm (algorithm) is not supported, " +
+			"it should be one of range_equally_divide, topic_count_equally_divide, specified_positions_divide",
+	}
+
+	positionsOut := cmdutils.Output{
+		Desc: "the positions are not specified for the specified_positions_divide algorithm",
+		Out:  "[✖]  the positions should be specified with the specified_positions_divide algorithm",
+	}
+	out = append(out, successOut, algorithmOut, positionsOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"split",
+		"Split a bundle of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	args := splitBundleArgs{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSplitNamespaceBundle(vc, &args)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Split", func(set *pflag.FlagSet) {
+		set.StringVarP(&args.bundle, "bundle", "b", "",
+			"the bundle to split, {start-boundary}_{end-boundary}")
+		set.StringVarP(&args.algorithm, "algorithm", "a", rangeEquallyDivide,
+			"the split algorithm, one of "+strings.Join(splitAlgorithms, ", "))
+		set.StringSliceVar(&args.positions, "positions", nil,
+			"the positions to split the bundle at with the specified_positions_divide algorithm, "+
+				"separated by commas")
+		set.BoolVarP(&args.unload, "unload", "u", false,
+			"unload the new bundles after splitting")
+		_ = cobra.MarkFlagRequired(set, "bundle")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSplitNamespaceBundle(vc *cmdutils.VerbCmd, args *splitBundleArgs) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	positions, err := parseSplitPositions(args.algorithm, args.positions)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"unload":             strconv.FormatBool(args.unload),
+		"splitAlgorithmName": args.algorithm,
+	}
+	// the positions are only sent with the specified_positions_divide algorithm
+	var body interface{}
+	if len(positions) > 0 {
+		body = positions
+	}
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.PutWithQueryParams(rc.Endpoint("/namespaces", ns.String(), args.bundle, "split"),
+		body, nil, params)
+	if err == nil {
+		vc.Command.Printf("Successfully split the bundle %s of the namespace %s\n", args.bundle, ns.String())
+	}
+	return err
+}
+
+// parseSplitPositions checks the split algorithm and parses the positions to split a bundle at,
+// a position is either a hex number prefixed with 0x or a decimal number
+func parseSplitPositions(algorithm string, positions []string) ([]int64, error) {
+	switch algorithm {
+	case rangeEquallyDivide, topicCountEquallyDivide:
+		if len(positions) > 0 {
+			return nil, errors.Errorf("the positions can only be specified with the %s algorithm",
+				specifiedPositionsDivide)
+		}
+		return nil, nil
+	case specifiedPositionsDivide:
+		if len(positions) == 0 {
+			return nil, errors.Errorf("the positions should be specified with the %s algorithm",
+				specifiedPositionsDivide)
+		}
+	default:
+		return nil, errors.Errorf("the split algorithm %s is not supported, it should be one of %s",
+			algorithm, strings.Join(splitAlgorithms, ", "))
+	}
+
+	values := make([]int64, 0, len(positions))
+	for _, p := range positions {
+		v, err := strconv.ParseUint(strings.TrimSpace(p), 0, 32)
+		if err != nil {
+			return nil, errors.Errorf("invalid position %s, the position should be in the range "+
+				"of 0x00000000 to 0xffffffff", p)
+		}
+		values = append(values, int64(v))
+	}
+	return values, nil
+}
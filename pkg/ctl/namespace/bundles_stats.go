@@ -0,0 +1,148 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func BundlesStatsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for getting the stats of the bundles of a namespace, " +
+		"the stats of a bundle is the sum of the stats of the topics served by the bundle."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	stats := cmdutils.Example{
+		Desc:    "Get the stats of the bundles of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces bundles stats (namespace-name)",
+	}
+
+	bundleStats := cmdutils.Example{
+		Desc:    "Get the stats of the bundle (bundle) of the namespace (namespace-name) in json",
+		Command: "pulsarctl namespaces bundles stats --bundle (bundle) -o json (namespace-name)",
+	}
+	examples = append(examples, stats, bundleStats)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-----------------------+--------+-----------+---------------+-----------+-------------+" +
+			"--------------+-------------------+--------------------+--------------+--------------+\n" +
+			"|        BUNDLE         | TOPICS | PRODUCERS | SUBSCRIPTIONS | CONSUMERS | MSG RATE IN |" +
+			" MSG RATE OUT | MSG THROUGHPUT IN | MSG THROUGHPUT OUT | STORAGE SIZE | BACKLOG SIZE |\n" +
+			"+-----------------------+--------+-----------+---------------+-----------+-------------+" +
+			"--------------+-------------------+--------------------+--------------+--------------+\n" +
+			"| 0x00000000_0x40000000 |      2 |         1 |             2 |         2 |       10.00 |" +
+			"        20.00 |          10240.00 |           20480.00 |      1048576 |            0 |\n" +
+			"+-----------------------+--------+-----------+---------------+-----------+-------------+" +
+			"--------------+-------------------+--------------------+--------------+--------------+",
+	}
+
+	notFoundOut := cmdutils.Output{
+		Desc: "the specified bundle does not exist",
+		Out:  "[✖]  the bundle (bundle) does not exist in the namespace (namespace-name)",
+	}
+	out = append(out, successOut, notFoundOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"stats",
+		"Get the stats of the bundles of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var bundle string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doBundlesStats(vc, bundle)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Stats", func(set *pflag.FlagSet) {
+		set.StringVarP(&bundle, "bundle", "b", "",
+			"only get the stats of the bundle, {start-boundary}_{end-boundary}")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doBundlesStats(vc *cmdutils.VerbCmd, bundle string) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	stats, err := collectBundleStats(admin, ns)
+	if err != nil {
+		return err
+	}
+
+	if bundle != "" {
+		stats, err = filterBundleStats(stats, bundle)
+		if err != nil {
+			return errors.Errorf("%v in the namespace %s", err, ns.String())
+		}
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(stats).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Bundle", "Topics", "Producers", "Subscriptions", "Consumers",
+				"Msg Rate In", "Msg Rate Out", "Msg Throughput In", "Msg Throughput Out",
+				"Storage Size", "Backlog Size"})
+			for _, b := range stats {
+				table.Append([]string{
+					b.Bundle,
+					strconv.Itoa(b.Topics),
+					strconv.Itoa(b.Producers),
+					strconv.Itoa(b.Subscriptions),
+					strconv.Itoa(b.Consumers),
+					fmt.Sprintf("%.2f", b.MsgRateIn),
+					fmt.Sprintf("%.2f", b.MsgRateOut),
+					fmt.Sprintf("%.2f", b.MsgThroughputIn),
+					fmt.Sprintf("%.2f", b.MsgThroughputOut),
+					strconv.FormatInt(b.StorageSize, 10),
+					strconv.FormatInt(b.BacklogSize, 10),
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func filterBundleStats(stats []BundleStats, bundle string) ([]BundleStats, error) {
+	for _, b := range stats {
+		if b.Bundle == bundle {
+			return []BundleStats{b}, nil
+		}
+	}
+	return nil, errors.Errorf("the bundle %s does not exist", bundle)
+}
@@ -0,0 +1,177 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/streamnative/pulsarctl/pkg/ctl/topic"
+)
+
+func TestBundleRanges(t *testing.T) {
+	assert.Equal(t,
+		[]string{"0x00000000_0x80000000", "0x80000000_0xffffffff"},
+		bundleRanges([]string{"0x00000000", "0x80000000", "0xffffffff"}))
+	assert.Empty(t, bundleRanges([]string{"0x00000000"}))
+	assert.Empty(t, bundleRanges(nil))
+}
+
+func TestBundleStatsAdd(t *testing.T) {
+	var b BundleStats
+	b.add(&utils.TopicStats{
+		MsgRateIn:   10,
+		MsgRateOut:  20,
+		StorageSize: 100,
+		Publishers:  []utils.PublisherStats{{}},
+		Subscriptions: map[string]utils.SubscriptionStats{
+			"sub-1": {Consumers: []utils.ConsumerStats{{}, {}}},
+			"sub-2": {},
+		},
+	})
+	b.add(&utils.TopicStats{MsgRateIn: 5, BacklogSize: 10})
+
+	assert.Equal(t, BundleStats{
+		Topics:        2,
+		Producers:     1,
+		Subscriptions: 2,
+		Consumers:     2,
+		MsgRateIn:     15,
+		MsgRateOut:    20,
+		StorageSize:   100,
+		BacklogSize:   10,
+	}, b)
+}
+
+func TestParseSplitPositions(t *testing.T) {
+	positions, err := parseSplitPositions(rangeEquallyDivide, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, positions)
+
+	positions, err = parseSplitPositions(specifiedPositionsDivide, []string{"0x40000000", " 2147483648"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0x40000000, 0x80000000}, positions)
+
+	_, err = parseSplitPositions(specifiedPositionsDivide, nil)
+	assert.EqualError(t, err, "the positions should be specified with the specified_positions_divide algorithm")
+
+	_, err = parseSplitPositions(topicCountEquallyDivide, []string{"0x40000000"})
+	assert.EqualError(t, err, "the positions can only be specified with the specified_positions_divide algorithm")
+
+	_, err = parseSplitPositions(specifiedPositionsDivide, []string{"0x100000000"})
+	assert.EqualError(t, err,
+		"invalid position 0x100000000, the position should be in the range of 0x00000000 to 0xffffffff")
+
+	_, err = parseSplitPositions("flow_equally_divide", nil)
+	assert.EqualError(t, err, "the split algorithm flow_equally_divide is not supported, "+
+		"it should be one of range_equally_divide, topic_count_equally_divide, specified_positions_divide")
+}
+
+func TestFilterBundleStats(t *testing.T) {
+	stats := []BundleStats{{Bundle: "0x00000000_0x80000000"}, {Bundle: "0x80000000_0xffffffff", Topics: 1}}
+
+	filtered, err := filterBundleStats(stats, "0x80000000_0xffffffff")
+	assert.Nil(t, err)
+	assert.Equal(t, []BundleStats{stats[1]}, filtered)
+
+	_, err = filterBundleStats(stats, "0x00000000_0x40000000")
+	assert.EqualError(t, err, "the bundle 0x00000000_0x40000000 does not exist")
+}
+
+func TestBundlesCmd(t *testing.T) {
+	ns := "public/test-bundles"
+	args := []string{"create", "--bundles", "4", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	require.Nil(t, execErr)
+
+	args = []string{"create", ns + "/test-topic", "0"}
+	_, execErr, argsErr, _ := topic.TestTopicCommands(topic.CreateTopicCmd, args)
+	require.Nil(t, argsErr)
+	require.Nil(t, execErr)
+
+	args = []string{"bundles", "-o", "json", ns}
+	out, execErr, _, _ := TestNamespaceCommands(BundlesCmd, args)
+	require.Nil(t, execErr)
+
+	var stats []BundleStats
+	require.Nil(t, json.Unmarshal(out.Bytes(), &stats))
+	assert.Equal(t, 4, len(stats))
+
+	topics := 0
+	for _, b := range stats {
+		topics += b.Topics
+		if b.Topics > 0 {
+			assert.NotEmpty(t, b.Owner)
+		}
+	}
+	assert.Equal(t, 1, topics)
+
+	args = []string{"stats", "--bundle", stats[0].Bundle, "-o", "json", ns}
+	out, execErr, _, _ = TestNamespaceCommands(BundlesStatsCmd, args)
+	require.Nil(t, execErr)
+
+	var bundleStats []BundleStats
+	require.Nil(t, json.Unmarshal(out.Bytes(), &bundleStats))
+	assert.Equal(t, 1, len(bundleStats))
+	assert.Equal(t, stats[0].Bundle, bundleStats[0].Bundle)
+
+	args = []string{"stats", "--bundle", "0x00000000_0x00000001", ns}
+	_, execErr, _, _ = TestNamespaceCommands(BundlesStatsCmd, args)
+	assert.EqualError(t, execErr, fmt.Sprintf("the bundle 0x00000000_0x00000001 does not exist in the namespace %s", ns))
+}
+
+func TestBundlesSplitAndUnloadAllCmd(t *testing.T) {
+	ns := "public/test-bundles-split"
+	args := []string{"create", "--bundles", "1", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	require.Nil(t, execErr)
+
+	args = []string{"split", "--bundle", "0x00000000_0xffffffff",
+		"--algorithm", specifiedPositionsDivide, "--positions", "0x40000000", ns}
+	out, execErr, _, _ := TestNamespaceCommands(BundlesSplitCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully split the bundle 0x00000000_0xffffffff of the namespace %s\n", ns),
+		out.String())
+
+	args = []string{"unload-all", "--interval", "0s", ns}
+	out, execErr, _, _ = TestNamespaceCommands(BundlesUnloadAllCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully unloaded the bundle 0x00000000_0x40000000 of the namespace %s\n", ns)+
+			fmt.Sprintf("Successfully unloaded the bundle 0x40000000_0xffffffff of the namespace %s\n", ns),
+		out.String())
+}
+
+func TestBundlesUnloadAllWithNegativeInterval(t *testing.T) {
+	args := []string{"unload-all", "--interval", "-1s", "public/test-bundles"}
+	_, execErr, _, _ := TestNamespaceCommands(BundlesUnloadAllCmd, args)
+	assert.EqualError(t, execErr, "the interval can not be negative")
+}
+
+func TestBundlesMoveWithInvalidBundleName(t *testing.T) {
+	args := []string{"move", "--to", "127.0.0.1:8080", "public/default"}
+	_, execErr, _, _ := TestNamespaceCommands(BundlesMoveCmd, args)
+	assert.EqualError(t, execErr, "invalid bundle name public/default, the bundle name should be in the form of "+
+		"<tenant>/<namespace>/<start-boundary>_<end-boundary>")
+}
@@ -0,0 +1,112 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func BundlesUnloadAllCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for unloading all the bundles of a namespace one by one, " +
+		"waiting for the interval between two unloads to avoid moving the load of the whole " +
+		"namespace at the same time."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	unload := cmdutils.Example{
+		Desc:    "Unload all the bundles of the namespace (namespace-name), one bundle every 5 seconds",
+		Command: "pulsarctl namespaces bundles unload-all --interval 5s (namespace-name)",
+	}
+	examples = append(examples, unload)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Successfully unloaded the bundle 0x00000000_0x80000000 of the namespace (namespace-name)\n" +
+			"Successfully unloaded the bundle 0x80000000_0xffffffff of the namespace (namespace-name)",
+	}
+
+	failedOut := cmdutils.Output{
+		Desc: "some of the bundles failed to be unloaded",
+		Out:  "[✖]  failed to unload (failed) of (total) bundles",
+	}
+	out = append(out, successOut, failedOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"unload-all",
+		"Unload all the bundles of a namespace with throttling",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var interval time.Duration
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doUnloadAllBundles(vc, interval)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Unload", func(set *pflag.FlagSet) {
+		set.DurationVarP(&interval, "interval", "i", time.Second,
+			"the time to wait between unloading two bundles")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doUnloadAllBundles(vc *cmdutils.VerbCmd, interval time.Duration) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if interval < 0 {
+		return errors.New("the interval can not be negative")
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	ranges, err := getBundleRanges(admin, ns)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for i, bundle := range ranges {
+		if i > 0 {
+			time.Sleep(interval)
+		}
+		if err := admin.Namespaces().UnloadNamespaceBundle(ns.String(), bundle); err != nil {
+			failed++
+			vc.Command.Printf("Failed to unload the bundle %s of the namespace %s: %v\n", bundle, ns.String(), err)
+			continue
+		}
+		vc.Command.Printf("Successfully unloaded the bundle %s of the namespace %s\n", bundle, ns.String())
+	}
+
+	if failed > 0 {
+		return errors.Errorf("failed to unload %d of %d bundles", failed, len(ranges))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelayedDeliveryCmd(t *testing.T) {
+	ns := "public/test-delayed-delivery"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"get-delayed-delivery", ns}
+	out, execErr, _, _ := TestNamespaceCommands(GetDelayedDeliveryCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("The delayed delivery policy of the namespace %s is not set\n", ns),
+		out.String())
+
+	args = []string{"set-delayed-delivery", "--enable", "--time", "2s", ns}
+	out, execErr, _, _ = TestNamespaceCommands(SetDelayedDeliveryCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully set the delayed delivery policy of the namespace %s\n", ns),
+		out.String())
+
+	args = []string{"get-delayed-delivery", ns}
+	out, execErr, _, _ = TestNamespaceCommands(GetDelayedDeliveryCmd, args)
+	assert.Nil(t, execErr)
+
+	var data utils.DelayedDeliveryData
+	err := json.Unmarshal(out.Bytes(), &data)
+	assert.Nil(t, err)
+	assert.True(t, data.Active)
+	assert.Equal(t, float64(2000), data.TickTime)
+
+	args = []string{"remove-delayed-delivery", ns}
+	out, execErr, _, _ = TestNamespaceCommands(RemoveDelayedDeliveryCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully removed the delayed delivery policy of the namespace %s\n", ns),
+		out.String())
+}
+
+func TestSetDelayedDeliveryWithoutEnableOrDisable(t *testing.T) {
+	args := []string{"set-delayed-delivery", "public/delayed-delivery"}
+	_, execErr, _, _ := TestNamespaceCommands(SetDelayedDeliveryCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "Need to specify either --enable or --disable", execErr.Error())
+
+	args = []string{"set-delayed-delivery", "--enable", "--disable", "public/delayed-delivery"}
+	_, execErr, _, _ = TestNamespaceCommands(SetDelayedDeliveryCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "Need to specify either --enable or --disable", execErr.Error())
+}
@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func GetDeduplicationSnapshotIntervalCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for getting the deduplication snapshot interval of a namespace."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	get := cmdutils.Example{
+		Desc:    "Get the deduplication snapshot interval of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces get-deduplication-snapshot-interval (namespace-name)",
+	}
+	examples = append(examples, get)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "The deduplication snapshot interval of the namespace (namespace-name) is (time) seconds",
+	}
+
+	notSetOut := cmdutils.Output{
+		Desc: "the deduplication snapshot interval is not set on the namespace",
+		Out:  "The deduplication snapshot interval of the namespace (namespace-name) is not set",
+	}
+	out = append(out, successOut, notSetOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-deduplication-snapshot-interval",
+		"Get the deduplication snapshot interval of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetDeduplicationSnapshotInterval(vc)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+}
+
+func doGetDeduplicationSnapshotInterval(vc *cmdutils.VerbCmd) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	seconds, err := getIntPolicy(ns, "deduplicationSnapshotInterval")
+	if err == nil {
+		if seconds == nil {
+			vc.Command.Printf("The deduplication snapshot interval of the namespace %s is not set\n", ns.String())
+		} else {
+			vc.Command.Printf("The deduplication snapshot interval of the namespace %s is %d seconds\n",
+				ns.String(), *seconds)
+		}
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// NamespaceResourceGroup is the resource group of a namespace, which is empty if it is not set
+type NamespaceResourceGroup struct {
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+func GetResourceGroupCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for getting the resource group of a namespace."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	get := cmdutils.Example{
+		Desc:    "Get the resource group of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces get-resource-group (namespace-name)",
+	}
+	getJSON := cmdutils.Example{
+		Desc:    "Get the resource group of the namespace (namespace-name) in json",
+		Command: "pulsarctl namespaces get-resource-group (namespace-name) -o json",
+	}
+	examples = append(examples, get, getJSON)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "The resource group of the namespace (namespace-name) is (resource-group)",
+	}
+
+	notSetOut := cmdutils.Output{
+		Desc: "the resource group is not set on the namespace",
+		Out:  "The resource group of the namespace (namespace-name) is not set",
+	}
+	out = append(out, successOut, notSetOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-resource-group",
+		"Get the resource group of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetResourceGroup(vc)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doGetResourceGroup(vc *cmdutils.VerbCmd) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	body, err := rc.GetWithQueryParams(rc.Endpoint("/namespaces", ns.String(), "resourcegroup"), nil, nil, false)
+	if err != nil {
+		return err
+	}
+
+	rg := NamespaceResourceGroup{ResourceGroup: parseResourceGroupName(body)}
+	oc := cmdutils.NewOutputContent().
+		WithObject(rg).
+		WithTextFunc(func(w io.Writer) error {
+			if rg.ResourceGroup == "" {
+				_, err := fmt.Fprintf(w, "The resource group of the namespace %s is not set\n", ns.String())
+				return err
+			}
+			_, err := fmt.Fprintf(w, "The resource group of the namespace %s is %s\n", ns.String(), rg.ResourceGroup)
+			return err
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+// parseResourceGroupName parses the resource group name returned by the broker,
+// which is either a plain string or a json string
+func parseResourceGroupName(body []byte) string {
+	s := strings.TrimSpace(string(body))
+	var name string
+	if err := json.Unmarshal([]byte(s), &name); err == nil {
+		return name
+	}
+	if s == "null" {
+		return ""
+	}
+	return s
+}
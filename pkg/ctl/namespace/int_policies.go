@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+// getIntPolicy gets a policy of the namespace which is an integer, nil is returned if
+// the policy is not set on the namespace
+func getIntPolicy(ns *utils.NameSpaceName, policy string) (*int, error) {
+	var value *int
+	rc := cmdutils.NewPulsarRestClient()
+	err := rc.Get(rc.Endpoint("/namespaces", ns.String(), policy), &value)
+	return value, err
+}
+
+func setIntPolicy(ns *utils.NameSpaceName, policy string, value int) error {
+	rc := cmdutils.NewPulsarRestClient()
+	return rc.Post(rc.Endpoint("/namespaces", ns.String(), policy), value)
+}
+
+func removePolicy(ns *utils.NameSpaceName, policy string) error {
+	rc := cmdutils.NewPulsarRestClient()
+	return rc.Delete(rc.Endpoint("/namespaces", ns.String(), policy))
+}
+
+// parseTimeInUnit parses a time which is either a plain number of the given unit
+// or a relative time such as 30s, 10m or 2h, and returns it as a number of the unit
+func parseTimeInUnit(s string, unit time.Duration) (int, error) {
+	if v, err := strconv.Atoi(s); err == nil {
+		if v < 0 {
+			return 0, errors.Errorf("the time %s can not be negative", s)
+		}
+		return v, nil
+	}
+
+	d, err := ctlutils.ParseRelativeTimeInSeconds(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, errors.Errorf("the time %s can not be negative", s)
+	}
+	if d%unit != 0 {
+		return 0, errors.Errorf("the time %s is not a whole number of %s", s, unitName(unit))
+	}
+	return int(d / unit), nil
+}
+
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Minute:
+		return "minutes"
+	case time.Millisecond:
+		return "milliseconds"
+	default:
+		return "seconds"
+	}
+}
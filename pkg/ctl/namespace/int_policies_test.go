@@ -0,0 +1,167 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type intPolicyTestCase struct {
+	// name is the suffix of the get, set and remove commands
+	name string
+	// desc is how the policy is called in the command output
+	desc               string
+	get, set, remove   func(cmd *cmdutils.VerbCmd)
+	flag, value        string
+	unit               string
+	expected           int
+	invalidValue       string
+	invalidValueErrMsg string
+}
+
+var intPolicyTestCases = []intPolicyTestCase{
+	{
+		name:               "max-topics-per-namespace",
+		desc:               "max topics per namespace",
+		get:                GetMaxTopicsPerNamespaceCmd,
+		set:                SetMaxTopicsPerNamespaceCmd,
+		remove:             RemoveMaxTopicsPerNamespaceCmd,
+		flag:               "--size",
+		value:              "10",
+		expected:           10,
+		invalidValue:       "-1",
+		invalidValueErrMsg: "the max topics per namespace can not be negative",
+	},
+	{
+		name:               "max-unacked-messages-per-consumer",
+		desc:               "max unacked messages per consumer",
+		get:                GetMaxUnackedMessagesPerConsumerCmd,
+		set:                SetMaxUnackedMessagesPerConsumerCmd,
+		remove:             RemoveMaxUnackedMessagesPerConsumerCmd,
+		flag:               "--size",
+		value:              "100",
+		expected:           100,
+		invalidValue:       "-1",
+		invalidValueErrMsg: "the max unacked messages per consumer can not be negative",
+	},
+	{
+		name:               "max-unacked-messages-per-subscription",
+		desc:               "max unacked messages per subscription",
+		get:                GetMaxUnackedMessagesPerSubscriptionCmd,
+		set:                SetMaxUnackedMessagesPerSubscriptionCmd,
+		remove:             RemoveMaxUnackedMessagesPerSubscriptionCmd,
+		flag:               "--size",
+		value:              "1000",
+		expected:           1000,
+		invalidValue:       "-1",
+		invalidValueErrMsg: "the max unacked messages per subscription can not be negative",
+	},
+	{
+		name:               "subscription-expiration-time",
+		desc:               "subscription expiration time",
+		get:                GetSubscriptionExpirationTimeCmd,
+		set:                SetSubscriptionExpirationTimeCmd,
+		remove:             RemoveSubscriptionExpirationTimeCmd,
+		flag:               "--time",
+		value:              "2h",
+		unit:               " minutes",
+		expected:           120,
+		invalidValue:       "-1",
+		invalidValueErrMsg: "the time -1 can not be negative",
+	},
+	{
+		name:               "deduplication-snapshot-interval",
+		desc:               "deduplication snapshot interval",
+		get:                GetDeduplicationSnapshotIntervalCmd,
+		set:                SetDeduplicationSnapshotIntervalCmd,
+		remove:             RemoveDeduplicationSnapshotIntervalCmd,
+		flag:               "--interval",
+		value:              "1m",
+		unit:               " seconds",
+		expected:           60,
+		invalidValue:       "90x",
+		invalidValueErrMsg: "invalid time unit 'x'",
+	},
+}
+
+func TestIntPoliciesCmd(t *testing.T) {
+	for _, c := range intPolicyTestCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			ns := "public/test-" + c.name
+			_, execErr, _, _ := TestNamespaceCommands(createNs, []string{"create", ns})
+			assert.Nil(t, execErr)
+
+			notSet := fmt.Sprintf("The %s of the namespace %s is not set\n", c.desc, ns)
+
+			out, execErr, _, _ := TestNamespaceCommands(c.get, []string{"get-" + c.name, ns})
+			assert.Nil(t, execErr)
+			assert.Equal(t, notSet, out.String())
+
+			out, execErr, _, _ = TestNamespaceCommands(c.set, []string{"set-" + c.name, c.flag, c.value, ns})
+			assert.Nil(t, execErr)
+			assert.Equal(t,
+				fmt.Sprintf("Successfully set the %s of the namespace %s to %d%s\n", c.desc, ns, c.expected, c.unit),
+				out.String())
+
+			out, execErr, _, _ = TestNamespaceCommands(c.get, []string{"get-" + c.name, ns})
+			assert.Nil(t, execErr)
+			assert.Equal(t,
+				fmt.Sprintf("The %s of the namespace %s is %d%s\n", c.desc, ns, c.expected, c.unit),
+				out.String())
+
+			out, execErr, _, _ = TestNamespaceCommands(c.remove, []string{"remove-" + c.name, ns})
+			assert.Nil(t, execErr)
+			assert.Equal(t,
+				fmt.Sprintf("Successfully removed the %s of the namespace %s\n", c.desc, ns),
+				out.String())
+
+			out, execErr, _, _ = TestNamespaceCommands(c.get, []string{"get-" + c.name, ns})
+			assert.Nil(t, execErr)
+			assert.Equal(t, notSet, out.String())
+		})
+	}
+}
+
+func TestSetIntPoliciesOnNonExistingNamespace(t *testing.T) {
+	for _, c := range intPolicyTestCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			args := []string{"set-" + c.name, c.flag, c.value, "public/non-existing-namespace"}
+			_, execErr, _, _ := TestNamespaceCommands(c.set, args)
+			assert.NotNil(t, execErr)
+			assertNamespaceNotExistError(t, execErr)
+		})
+	}
+}
+
+func TestSetIntPoliciesWithInvalidValue(t *testing.T) {
+	for _, c := range intPolicyTestCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			args := []string{"set-" + c.name, c.flag, c.invalidValue, "public/invalid-value"}
+			_, execErr, _, _ := TestNamespaceCommands(c.set, args)
+			assert.NotNil(t, execErr)
+			assert.Equal(t, c.invalidValueErrMsg, execErr.Error())
+		})
+	}
+}
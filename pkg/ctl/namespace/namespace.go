@@ -54,6 +54,9 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, ClearOffloadDeletionLagCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetOffloadThresholdCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetOffloadThresholdCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetOffloadPoliciesCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetOffloadPoliciesCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveOffloadPoliciesCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetCompactionThresholdCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetCompactionThresholdCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetMaxConsumersPerSubscriptionCmd)
@@ -97,5 +100,41 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetInactiveTopicCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetInactiveTopicCmd)
 	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveInactiveTopicCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetDelayedDeliveryCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetDelayedDeliveryCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveDelayedDeliveryCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetMaxUnackedMessagesPerConsumerCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetMaxUnackedMessagesPerConsumerCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxUnackedMessagesPerConsumerCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetMaxUnackedMessagesPerSubscriptionCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetMaxUnackedMessagesPerSubscriptionCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxUnackedMessagesPerSubscriptionCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetMaxTopicsPerNamespaceCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetMaxTopicsPerNamespaceCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxTopicsPerNamespaceCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetSubscriptionExpirationTimeCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetSubscriptionExpirationTimeCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveSubscriptionExpirationTimeCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetDeduplicationSnapshotIntervalCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetDeduplicationSnapshotIntervalCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveDeduplicationSnapshotIntervalCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveDispatchRateCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveSubscriptionDispatchRateCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveReplicatorDispatchRateCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveSubscribeRateCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemovePublishRateCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemovePersistenceCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveRetentionCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMessageTTLCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveDeduplicationCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxProducersPerTopicCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxConsumersPerTopicCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveMaxConsumersPerSubscriptionCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, ResetPoliciesCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, SetResourceGroupCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, GetResourceGroupCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, RemoveResourceGroupCmd)
+	cmdutils.AddVerbCmd(flagGrouping, resourceCmd, TopCmd)
+	resourceCmd.AddCommand(bundlesCommand(flagGrouping))
 	return resourceCmd
 }
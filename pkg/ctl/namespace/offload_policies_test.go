@@ -0,0 +1,74 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+	"github.com/streamnative/pulsarctl/pkg/test"
+)
+
+func TestOffloadPoliciesCmd(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	nsName := fmt.Sprintf("public/test-offload-policies-ns-%s", test.RandomSuffix())
+	createArgs := []string{"create", nsName}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, createArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	setArgs := []string{"set-offload-policies", nsName,
+		"--driver", "google-cloud-storage",
+		"--bucket", "test-bucket",
+		"--region", "europe-west3",
+		"--threshold", "1g",
+		"--read-priority", "bookkeeper-first"}
+	out, execErr, _, _ := TestNamespaceCommands(SetOffloadPoliciesCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(out.String()).Should(gomega.Equal(fmt.Sprintf("Set offload policies successfully for [%s]\n", nsName)))
+
+	getArgs := []string{"get-offload-policies", nsName}
+	g.Eventually(func(g gomega.Gomega) {
+		out, execErr, _, _ = TestNamespaceCommands(GetOffloadPoliciesCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var policies ctlutils.OffloadPolicies
+		err := json.Unmarshal(out.Bytes(), &policies)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(policies.ManagedLedgerOffloadDriver).Should(gomega.Equal("google-cloud-storage"))
+		g.Expect(policies.GcsManagedLedgerOffloadBucket).Should(gomega.Equal("test-bucket"))
+		g.Expect(policies.GcsManagedLedgerOffloadRegion).Should(gomega.Equal("europe-west3"))
+		g.Expect(policies.ManagedLedgerOffloadThresholdInBytes).Should(gomega.Equal(int64(1024 * 1024 * 1024)))
+		g.Expect(policies.ManagedLedgerOffloadedReadPriority).Should(gomega.Equal("BOOKKEEPER_FIRST"))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-offload-policies", nsName}
+	out, execErr, _, _ = TestNamespaceCommands(RemoveOffloadPoliciesCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(out.String()).Should(gomega.Equal(fmt.Sprintf("Remove offload policies successfully from [%s]\n",
+		nsName)))
+
+	g.Eventually(func(g gomega.Gomega) {
+		out, execErr, _, _ = TestNamespaceCommands(GetOffloadPoliciesCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(out.String()).Should(gomega.Equal("null"))
+	}).Should(gomega.Succeed())
+}
@@ -18,7 +18,12 @@
 package namespace
 
 import (
+	"io"
+
+	"github.com/spf13/pflag"
+
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
 )
 
 func getPolicies(vc *cmdutils.VerbCmd) {
@@ -31,7 +36,12 @@ func getPolicies(vc *cmdutils.VerbCmd) {
 		Desc:    "Get the configuration policies of a namespace",
 		Command: "pulsarctl namespaces policies (tenant/namespace)",
 	}
-	examples = append(examples, police)
+	effectivePolicies := cmdutils.Example{
+		Desc: "Get the effective policies of a namespace, " +
+			"showing whether each value comes from the namespace or the broker configuration",
+		Command: "pulsarctl namespaces policies (tenant/namespace) --effective",
+	}
+	examples = append(examples, police, effectivePolicies)
 	desc.CommandExamples = examples
 
 	var out []cmdutils.Output
@@ -114,7 +124,18 @@ func getPolicies(vc *cmdutils.VerbCmd) {
 		Out:  "[✖]  code: 404 reason: Namespace (tenant/namespace) does not exist",
 	}
 
-	out = append(out, successOut, noNamespaceName, tenantNotExistError, nsNotExistError)
+	effectiveOut := cmdutils.Output{
+		Desc: "the effective policies",
+		Out: "+-----------------------------+-----------+-----------+-----------+--------+\n" +
+			"|           POLICY            | EFFECTIVE |  SOURCE   | NAMESPACE | BROKER |\n" +
+			"+-----------------------------+-----------+-----------+-----------+--------+\n" +
+			"| messageTTL                  | 3600      | namespace | 3600      | 0      |\n" +
+			"| maxProducers                | 0         | broker    | -         | 0      |\n" +
+			"| schemaCompatibilityStrategy | FULL      | broker    | -         | FULL   |\n" +
+			"+-----------------------------+-----------+-----------+-----------+--------+",
+	}
+
+	out = append(out, successOut, effectiveOut, noNamespaceName, tenantNotExistError, nsNotExistError)
 	desc.CommandOutput = out
 
 	vc.SetDescription(
@@ -125,15 +146,23 @@ func getPolicies(vc *cmdutils.VerbCmd) {
 		"policies",
 	)
 
+	var effective bool
 	vc.SetRunFuncWithNameArg(func() error {
-		return doGetPolicies(vc)
+		return doGetPolicies(vc, effective)
 	}, "the namespace name is not specified or the namespace name is specified more than one")
 
+	vc.FlagSetGroup.InFlagSet("Policies", func(set *pflag.FlagSet) {
+		set.BoolVar(&effective, "effective", false,
+			"Show the effective value of each policy and whether it comes from the namespace or the broker")
+	})
 	vc.EnableOutputFlagSet()
 }
 
-func doGetPolicies(vc *cmdutils.VerbCmd) error {
+func doGetPolicies(vc *cmdutils.VerbCmd, effective bool) error {
 	namespace := vc.NameArg
+	if effective {
+		return doGetEffectivePolicies(vc, namespace)
+	}
 	admin := cmdutils.NewPulsarClient()
 	policies, err := admin.Namespaces().GetPolicies(namespace)
 	if err == nil {
@@ -142,3 +171,24 @@ func doGetPolicies(vc *cmdutils.VerbCmd) error {
 	}
 	return err
 }
+
+func doGetEffectivePolicies(vc *cmdutils.VerbCmd, namespace string) error {
+	rc := cmdutils.NewPulsarRestClient()
+	namespaceGetter := ctlutils.NewPolicyLevelGetter(func(path string) ([]byte, error) {
+		return rc.GetWithQueryParams(rc.Endpoint("/namespaces", namespace, path), nil, nil, false)
+	})
+	brokerConfig, err := cmdutils.NewPulsarClient().Brokers().GetRuntimeConfigurations()
+	if err != nil {
+		return err
+	}
+	policies, err := ctlutils.ResolveEffectivePolicies(ctlutils.PolicyDefinitions, nil, namespaceGetter, brokerConfig)
+	if err != nil {
+		return err
+	}
+	oc := cmdutils.NewOutputContent().
+		WithObject(policies).
+		WithTextFunc(func(w io.Writer) error {
+			return ctlutils.WriteEffectivePolicies(w, policies, false)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/stretchr/testify/assert"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
 )
 
 func TestPolicesCommand(t *testing.T) {
@@ -71,3 +73,34 @@ func TestPolicesNonExistNs(t *testing.T) {
 	assert.NotNil(t, execErr)
 	assertNamespaceNotExistError(t, execErr)
 }
+
+func TestEffectivePoliciesCommand(t *testing.T) {
+	ns := "public/test-effective-policy-namespace"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"set-message-ttl", ns, "-t", "3600"}
+	_, execErr, _, _ = TestNamespaceCommands(setMessageTTL, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"policies", ns, "--effective", "-o", "json"}
+	out, execErr, _, _ := TestNamespaceCommands(getPolicies, args)
+	assert.Nil(t, execErr)
+
+	var policies []ctlutils.EffectivePolicy
+	err := json.Unmarshal(out.Bytes(), &policies)
+	assert.Nil(t, err)
+	assert.Len(t, policies, len(ctlutils.PolicyDefinitions))
+	for _, p := range policies {
+		assert.Nil(t, p.Topic)
+		switch p.Policy {
+		case "messageTTL":
+			assert.Equal(t, float64(3600), p.Value)
+			assert.Equal(t, ctlutils.PolicyLevelNamespace, p.Source)
+		case "maxMessageSize":
+			assert.Nil(t, p.Namespace)
+			assert.Equal(t, ctlutils.PolicyLevelBroker, p.Source)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func RemoveMaxConsumersPerSubscriptionCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for removing the max consumers per subscription of a namespace, " +
+		"the broker level setting is applied after it is removed."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	remove := cmdutils.Example{
+		Desc:    "Remove the max consumers per subscription of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces remove-max-consumers-per-subscription (namespace-name)",
+	}
+	examples = append(examples, remove)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully removed the max consumers per subscription of the namespace (namespace-name)",
+	}
+	out = append(out, successOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"remove-max-consumers-per-subscription",
+		"Remove the max consumers per subscription of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doRemoveMaxConsumersPerSubscription(vc)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+}
+
+func doRemoveMaxConsumersPerSubscription(vc *cmdutils.VerbCmd) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	err = removePolicy(ns, "maxConsumersPerSubscription")
+	if err == nil {
+		vc.Command.Printf("Successfully removed the max consumers per subscription of the namespace %s\n", ns.String())
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func TestRemovePoliciesCmd(t *testing.T) {
+	ns := "public/test-remove-policies"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	assert.Nil(t, execErr)
+
+	cases := []struct {
+		cmd   func(vc *cmdutils.VerbCmd)
+		name  string
+		words string
+	}{
+		{RemoveDispatchRateCmd, "dispatch-rate", "dispatch rate"},
+		{RemoveSubscriptionDispatchRateCmd, "subscription-dispatch-rate", "subscription dispatch rate"},
+		{RemoveReplicatorDispatchRateCmd, "replicator-dispatch-rate", "replicator dispatch rate"},
+		{RemoveSubscribeRateCmd, "subscribe-rate", "subscribe rate"},
+		{RemovePublishRateCmd, "publish-rate", "publish rate"},
+		{RemovePersistenceCmd, "persistence", "persistence policy"},
+		{RemoveRetentionCmd, "retention", "retention policy"},
+		{RemoveMessageTTLCmd, "message-ttl", "message TTL"},
+		{RemoveDeduplicationCmd, "deduplication", "deduplication status"},
+		{RemoveMaxProducersPerTopicCmd, "max-producers-per-topic", "max producers per topic"},
+		{RemoveMaxConsumersPerTopicCmd, "max-consumers-per-topic", "max consumers per topic"},
+		{RemoveMaxConsumersPerSubscriptionCmd, "max-consumers-per-subscription", "max consumers per subscription"},
+	}
+
+	for _, c := range cases {
+		args = []string{"remove-" + c.name, ns}
+		out, execErr, _, _ := TestNamespaceCommands(c.cmd, args)
+		assert.Nil(t, execErr, c.name)
+		assert.Equal(t,
+			fmt.Sprintf("Successfully removed the %s of the namespace %s\n", c.words, ns),
+			out.String())
+	}
+}
+
+func TestRemoveMaxConsumersPerTopicCmd(t *testing.T) {
+	ns := "public/test-remove-max-consumers-per-topic"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"set-max-consumers-per-topic", "--size", "10", ns}
+	_, execErr, _, _ = TestNamespaceCommands(SetMaxConsumersPerTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"remove-max-consumers-per-topic", ns}
+	_, execErr, _, _ = TestNamespaceCommands(RemoveMaxConsumersPerTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"get-max-consumers-per-topic", ns}
+	out, execErr, _, _ := TestNamespaceCommands(GetMaxConsumersPerTopicCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("The max consumers per topic of the namespace %s is not set\n", ns),
+		out.String())
+}
+
+func TestRemoveDispatchRateOnNonExistingNs(t *testing.T) {
+	ns := "public/non-existing-namespace"
+	args := []string{"remove-dispatch-rate", ns}
+	_, execErr, _, _ := TestNamespaceCommands(RemoveDispatchRateCmd, args)
+	assert.NotNil(t, execErr)
+	assertNamespaceNotExistError(t, execErr)
+}
@@ -0,0 +1,215 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// resettablePolicy is a namespace policy which falls back to the broker level setting
+// once it is removed, the name is the same as the suffix of its remove command
+type resettablePolicy struct {
+	name string
+	path string
+	// remove removes the policy with the admin client, the policies it does not cover
+	// are removed by their REST path
+	remove func(namespaces admin.Namespaces, ns utils.NameSpaceName) error
+}
+
+var resettablePolicies = []resettablePolicy{
+	{name: "backlog-quota", path: "backlogQuota",
+		remove: func(namespaces admin.Namespaces, ns utils.NameSpaceName) error {
+			return namespaces.RemoveBacklogQuota(ns.String())
+		}},
+	{name: "deduplication", path: "deduplication"},
+	{name: "deduplication-snapshot-interval", path: "deduplicationSnapshotInterval"},
+	{name: "delayed-delivery", path: "delayedDelivery"},
+	{name: "dispatch-rate", path: "dispatchRate"},
+	{name: "inactive-topic-policies", path: "inactiveTopicPolicies",
+		remove: admin.Namespaces.RemoveInactiveTopicPolicies},
+	{name: "max-consumers-per-subscription", path: "maxConsumersPerSubscription"},
+	{name: "max-consumers-per-topic", path: "maxConsumersPerTopic"},
+	{name: "max-producers-per-topic", path: "maxProducersPerTopic"},
+	{name: "max-topics-per-namespace", path: "maxTopicsPerNamespace",
+		remove: admin.Namespaces.RemoveMaxTopicsPerNamespace},
+	{name: "max-unacked-messages-per-consumer", path: "maxUnackedMessagesPerConsumer"},
+	{name: "max-unacked-messages-per-subscription", path: "maxUnackedMessagesPerSubscription"},
+	{name: "message-ttl", path: "messageTTL"},
+	{name: "offload-policies", path: "removeOffloadPolicies"},
+	{name: "persistence", path: "persistence",
+		remove: func(namespaces admin.Namespaces, ns utils.NameSpaceName) error {
+			return namespaces.RemovePersistence(ns.String())
+		}},
+	{name: "publish-rate", path: "publishRate"},
+	{name: "replicator-dispatch-rate", path: "replicatorDispatchRate"},
+	{name: "retention", path: "retention"},
+	{name: "subscribe-rate", path: "subscribeRate"},
+	{name: "subscription-dispatch-rate", path: "subscriptionDispatchRate"},
+	{name: "subscription-expiration-time", path: "subscriptionExpirationTime",
+		remove: admin.Namespaces.RemoveSubscriptionExpirationTime},
+	{name: "topic-auto-creation", path: "autoTopicCreation",
+		remove: admin.Namespaces.RemoveTopicAutoCreation},
+}
+
+func ResetPoliciesCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for resetting the policies of a namespace to the broker level " +
+		"settings, which is the same as running the remove command of each policy. The policies which can be " +
+		"reset are: " + strings.Join(resettablePolicyNames(), ", ") + "."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	resetOnly := cmdutils.Example{
+		Desc:    "Reset the dispatch rate and the retention policy of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces reset-policies --only dispatch-rate,retention (namespace-name)",
+	}
+
+	resetAll := cmdutils.Example{
+		Desc:    "Reset all the policies of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces reset-policies --all (namespace-name)",
+	}
+	examples = append(examples, resetOnly, resetAll)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Successfully reset the dispatch-rate of the namespace (namespace-name)\n" +
+			"Successfully reset the retention of the namespace (namespace-name)",
+	}
+
+	flagErrOut := cmdutils.Output{
+		Desc: "neither or both of --only and --all are specified",
+		Out:  "[✖]  either --only or --all should be specified",
+	}
+
+	unknownOut := cmdutils.Output{
+		Desc: "the specified policy can not be reset",
+		Out:  "[✖]  unknown policy (policy), the policies which can be reset are: (policies)",
+	}
+	out = append(out, successOut, flagErrOut, unknownOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"reset-policies",
+		"Reset the policies of a namespace to the broker level settings",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var policies []string
+	var all bool
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doResetPolicies(vc, policies, all)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Reset Policies", func(set *pflag.FlagSet) {
+		set.StringSliceVar(&policies, "only", nil,
+			"the policies to reset, separated by commas")
+		set.BoolVar(&all, "all", false,
+			"reset all the policies")
+	})
+}
+
+func doResetPolicies(vc *cmdutils.VerbCmd, names []string, all bool) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if all == (len(names) > 0) {
+		return errors.New("either --only or --all should be specified")
+	}
+
+	policies := resettablePolicies
+	if !all {
+		policies, err = selectResettablePolicies(names)
+		if err != nil {
+			return err
+		}
+	}
+
+	namespaces := cmdutils.NewPulsarClient().Namespaces()
+	failed := 0
+	for _, p := range policies {
+		if err := p.removeFrom(namespaces, ns); err != nil {
+			failed++
+			vc.Command.Printf("Failed to reset the %s of the namespace %s: %v\n", p.name, ns.String(), err)
+			continue
+		}
+		vc.Command.Printf("Successfully reset the %s of the namespace %s\n", p.name, ns.String())
+	}
+
+	if failed > 0 {
+		return errors.Errorf("failed to reset %d of %d policies", failed, len(policies))
+	}
+	return nil
+}
+
+func (p resettablePolicy) removeFrom(namespaces admin.Namespaces, ns *utils.NameSpaceName) error {
+	if p.remove != nil {
+		return p.remove(namespaces, *ns)
+	}
+	return removePolicy(ns, p.path)
+}
+
+// selectResettablePolicies returns the policies of the given names in the order they are given,
+// a policy specified more than once is only reset once
+func selectResettablePolicies(names []string) ([]resettablePolicy, error) {
+	var policies []resettablePolicy
+	selected := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		p, ok := findResettablePolicy(name)
+		if !ok {
+			return nil, errors.Errorf("unknown policy %s, the policies which can be reset are: %s",
+				name, strings.Join(resettablePolicyNames(), ", "))
+		}
+		if selected[name] {
+			continue
+		}
+		selected[name] = true
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+func findResettablePolicy(name string) (resettablePolicy, bool) {
+	for _, p := range resettablePolicies {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return resettablePolicy{}, false
+}
+
+func resettablePolicyNames() []string {
+	names := make([]string, 0, len(resettablePolicies))
+	for _, p := range resettablePolicies {
+		names = append(names, p.name)
+	}
+	return names
+}
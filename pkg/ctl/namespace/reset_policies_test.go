@@ -0,0 +1,107 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func TestResetPoliciesCmd(t *testing.T) {
+	ns := "public/test-reset-policies"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"set-max-consumers-per-topic", "--size", "10", ns}
+	_, execErr, _, _ = TestNamespaceCommands(SetMaxConsumersPerTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"set-max-unacked-messages-per-consumer", "--size", "100", ns}
+	_, execErr, _, _ = TestNamespaceCommands(SetMaxUnackedMessagesPerConsumerCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"reset-policies", "--only", "max-consumers-per-topic,max-unacked-messages-per-consumer", ns}
+	out, execErr, _, _ := TestNamespaceCommands(ResetPoliciesCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully reset the max-consumers-per-topic of the namespace %s\n", ns)+
+			fmt.Sprintf("Successfully reset the max-unacked-messages-per-consumer of the namespace %s\n", ns),
+		out.String())
+
+	args = []string{"get-max-consumers-per-topic", ns}
+	out, execErr, _, _ = TestNamespaceCommands(GetMaxConsumersPerTopicCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("The max consumers per topic of the namespace %s is not set\n", ns),
+		out.String())
+
+	args = []string{"get-max-unacked-messages-per-consumer", ns}
+	out, execErr, _, _ = TestNamespaceCommands(GetMaxUnackedMessagesPerConsumerCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("The max unacked messages per consumer of the namespace %s is not set\n", ns),
+		out.String())
+
+	args = []string{"reset-policies", "--all", ns}
+	_, execErr, _, _ = TestNamespaceCommands(ResetPoliciesCmd, args)
+	assert.Nil(t, execErr)
+}
+
+func TestResetPoliciesWithInvalidFlags(t *testing.T) {
+	args := []string{"reset-policies", "public/reset-policies"}
+	_, execErr, _, _ := TestNamespaceCommands(ResetPoliciesCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "either --only or --all should be specified", execErr.Error())
+
+	args = []string{"reset-policies", "--all", "--only", "retention", "public/reset-policies"}
+	_, execErr, _, _ = TestNamespaceCommands(ResetPoliciesCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "either --only or --all should be specified", execErr.Error())
+
+	args = []string{"reset-policies", "--only", "retention,unknown", "public/reset-policies"}
+	_, execErr, _, _ = TestNamespaceCommands(ResetPoliciesCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Contains(t, execErr.Error(), "unknown policy unknown, the policies which can be reset are: ")
+}
+
+func TestSelectResettablePolicies(t *testing.T) {
+	policies, err := selectResettablePolicies([]string{"retention", " message-ttl", "retention"})
+	assert.Nil(t, err)
+	assert.Equal(t, []resettablePolicy{
+		{name: "retention", path: "retention"},
+		{name: "message-ttl", path: "messageTTL"},
+	}, policies)
+
+	_, err = selectResettablePolicies([]string{"retention-policy"})
+	assert.NotNil(t, err)
+}
+
+func TestResettablePoliciesHaveRemoveCommands(t *testing.T) {
+	for _, name := range resettablePolicyNames() {
+		cmd, _, err := Command(cmdutils.NewGrouping()).Find([]string{"remove-" + name})
+		assert.Nil(t, err, name)
+		if err == nil {
+			assert.Equal(t, "remove-"+name, cmd.Name())
+		}
+	}
+}
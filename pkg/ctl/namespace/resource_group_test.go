@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func TestParseResourceGroupName(t *testing.T) {
+	assert.Equal(t, "rg", parseResourceGroupName([]byte("rg")))
+	assert.Equal(t, "rg", parseResourceGroupName([]byte(`"rg"`)))
+	assert.Equal(t, "", parseResourceGroupName([]byte("")))
+	assert.Equal(t, "", parseResourceGroupName([]byte("null")))
+}
+
+func TestResourceGroupCmd(t *testing.T) {
+	ns := "public/test-resource-group"
+	args := []string{"create", ns}
+	_, execErr, _, _ := TestNamespaceCommands(createNs, args)
+	require.Nil(t, execErr)
+
+	rg := "test-namespace-resource-group"
+	rc := cmdutils.NewPulsarRestClient()
+	require.Nil(t, rc.Put(rc.Endpoint("/resourcegroups", rg), map[string]int64{"publishRateInMsgs": 100}))
+
+	args = []string{"get-resource-group", ns}
+	out, execErr, _, _ := TestNamespaceCommands(GetResourceGroupCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, fmt.Sprintf("The resource group of the namespace %s is not set\n", ns), out.String())
+
+	args = []string{"set-resource-group", "--resource-group", rg, ns}
+	out, execErr, _, _ = TestNamespaceCommands(SetResourceGroupCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t,
+		fmt.Sprintf("Successfully set the resource group of the namespace %s to %s\n", ns, rg),
+		out.String())
+
+	args = []string{"get-resource-group", ns}
+	out, execErr, _, _ = TestNamespaceCommands(GetResourceGroupCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, fmt.Sprintf("The resource group of the namespace %s is %s\n", ns, rg), out.String())
+
+	args = []string{"get-resource-group", "-o", "json", ns}
+	out, execErr, _, _ = TestNamespaceCommands(GetResourceGroupCmd, args)
+	require.Nil(t, execErr)
+	var nrg NamespaceResourceGroup
+	require.Nil(t, json.Unmarshal(out.Bytes(), &nrg))
+	assert.Equal(t, rg, nrg.ResourceGroup)
+
+	args = []string{"remove-resource-group", ns}
+	out, execErr, _, _ = TestNamespaceCommands(RemoveResourceGroupCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, fmt.Sprintf("Successfully removed the resource group of the namespace %s\n", ns), out.String())
+
+	require.Nil(t, rc.Delete(rc.Endpoint("/resourcegroups", rg)))
+}
@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetDeduplicationSnapshotIntervalCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the deduplication snapshot interval of a namespace. " +
+		"The broker takes a snapshot of the deduplication state at this interval, 0 means disabled."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	set := cmdutils.Example{
+		Desc:    "Set the deduplication snapshot interval of the namespace (namespace-name) to 600 seconds",
+		Command: "pulsarctl namespaces set-deduplication-snapshot-interval --interval 600 (namespace-name)",
+	}
+
+	setRelative := cmdutils.Example{
+		Desc:    "Set the deduplication snapshot interval of the namespace (namespace-name) to 10m",
+		Command: "pulsarctl namespaces set-deduplication-snapshot-interval --interval 10m (namespace-name)",
+	}
+	examples = append(examples, set, setRelative)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the deduplication snapshot interval of the namespace (namespace-name) to (time) seconds",
+	}
+
+	invalidTimeOut := cmdutils.Output{
+		Desc: "the specified time is negative",
+		Out:  "[✖]  the time (time) can not be negative",
+	}
+	out = append(out, successOut, invalidTimeOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-deduplication-snapshot-interval",
+		"Set the deduplication snapshot interval of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var t string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetDeduplicationSnapshotInterval(vc, t)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Deduplication Snapshot Interval", func(set *pflag.FlagSet) {
+		set.StringVarP(&t, "interval", "i", "",
+			"deduplication snapshot interval in seconds, or a relative time (eg: 10m)")
+		_ = cobra.MarkFlagRequired(set, "interval")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetDeduplicationSnapshotInterval(vc *cmdutils.VerbCmd, t string) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	seconds, err := parseTimeInUnit(t, time.Second)
+	if err != nil {
+		return err
+	}
+
+	err = setIntPolicy(ns, "deduplicationSnapshotInterval", seconds)
+	if err == nil {
+		vc.Command.Printf("Successfully set the deduplication snapshot interval of the namespace %s to %d seconds\n",
+			ns.String(), seconds)
+	}
+
+	return err
+}
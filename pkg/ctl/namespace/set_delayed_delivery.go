@@ -0,0 +1,113 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func SetDelayedDeliveryCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the delayed delivery policy of a namespace, " +
+		"the policy is applied to the topics of the namespace which do not have their own policy."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	enable := cmdutils.Example{
+		Desc:    "Enable the delayed delivery of the namespace (namespace-name) with a tick time of 10 seconds",
+		Command: "pulsarctl namespaces set-delayed-delivery --enable --time 10s (namespace-name)",
+	}
+
+	disable := cmdutils.Example{
+		Desc:    "Disable the delayed delivery of the namespace (namespace-name)",
+		Command: "pulsarctl namespaces set-delayed-delivery --disable (namespace-name)",
+	}
+	examples = append(examples, enable, disable)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the delayed delivery policy of the namespace (namespace-name)",
+	}
+
+	flagErrOut := cmdutils.Output{
+		Desc: "neither or both of --enable and --disable are specified",
+		Out:  "[✖]  Need to specify either --enable or --disable",
+	}
+	out = append(out, successOut, flagErrOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-delayed-delivery",
+		"Set the delayed delivery policy of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	data := &utils.DelayedDeliveryCmdData{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetDelayedDelivery(vc, data)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Delayed Delivery", func(set *pflag.FlagSet) {
+		set.BoolVarP(&data.Enable, "enable", "e", false,
+			"Enable delayed delivery messages")
+		set.BoolVarP(&data.Disable, "disable", "d", false,
+			"Disable delayed delivery messages")
+		set.StringVarP(&data.DelayedDeliveryTimeStr, "time", "t", "1s",
+			"The tick time for when retrying on delayed delivery messages, affecting the"+
+				" accuracy of the delivery time compared to the scheduled time. (eg: 1s, 10s, 1m, 5h, 3d)")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetDelayedDelivery(vc *cmdutils.VerbCmd, data *utils.DelayedDeliveryCmdData) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if data.Enable == data.Disable {
+		return errors.New("Need to specify either --enable or --disable")
+	}
+
+	policy := utils.DelayedDeliveryData{}
+	if data.Enable {
+		tickTime, err := ctlutils.ParseRelativeTimeInSeconds(data.DelayedDeliveryTimeStr)
+		if err != nil {
+			return err
+		}
+		// the namespace policy takes the tick time in milliseconds
+		policy.TickTime = float64(tickTime.Milliseconds())
+		policy.Active = true
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.Post(rc.Endpoint("/namespaces", ns.String(), "delayedDelivery"), &policy)
+	if err == nil {
+		vc.Command.Printf("Successfully set the delayed delivery policy of the namespace %s\n", ns.String())
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetMaxTopicsPerNamespaceCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the max topics per namespace of a namespace. " +
+		"No more topics can be created in the namespace once it has that many topics, 0 means no limit."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	set := cmdutils.Example{
+		Desc:    "Set the max topics per namespace of the namespace (namespace-name) to (size)",
+		Command: "pulsarctl namespaces set-max-topics-per-namespace --size (size) (namespace-name)",
+	}
+	examples = append(examples, set)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the max topics per namespace of the namespace (namespace-name) to (size)",
+	}
+
+	invalidSizeOut := cmdutils.Output{
+		Desc: "the specified size is negative",
+		Out:  "[✖]  the max topics per namespace can not be negative",
+	}
+	out = append(out, successOut, invalidSizeOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-max-topics-per-namespace",
+		"Set the max topics per namespace of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var num int
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetMaxTopicsPerNamespace(vc, num)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Max Topics Per Namespace", func(set *pflag.FlagSet) {
+		set.IntVar(&num, "size", -1, "max topics per namespace")
+		_ = cobra.MarkFlagRequired(set, "size")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetMaxTopicsPerNamespace(vc *cmdutils.VerbCmd, max int) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if max < 0 {
+		return errors.New("the max topics per namespace can not be negative")
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Namespaces().SetMaxTopicsPerNamespace(*ns, max)
+	if err == nil {
+		vc.Command.Printf("Successfully set the max topics per namespace of the namespace %s to %d\n",
+			ns.String(), max)
+	}
+
+	return err
+}
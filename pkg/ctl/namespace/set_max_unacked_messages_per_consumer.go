@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetMaxUnackedMessagesPerConsumerCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the max unacked messages per consumer of a namespace. " +
+		"A consumer stops receiving messages once it has that many unacknowledged messages, 0 means no limit."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	set := cmdutils.Example{
+		Desc:    "Set the max unacked messages per consumer of the namespace (namespace-name) to (size)",
+		Command: "pulsarctl namespaces set-max-unacked-messages-per-consumer --size (size) (namespace-name)",
+	}
+	examples = append(examples, set)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the max unacked messages per consumer of the namespace (namespace-name) to (size)",
+	}
+
+	invalidSizeOut := cmdutils.Output{
+		Desc: "the specified size is negative",
+		Out:  "[✖]  the max unacked messages per consumer can not be negative",
+	}
+	out = append(out, successOut, invalidSizeOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-max-unacked-messages-per-consumer",
+		"Set the max unacked messages per consumer of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var num int
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetMaxUnackedMessagesPerConsumer(vc, num)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Max Unacked Messages Per Consumer", func(set *pflag.FlagSet) {
+		set.IntVar(&num, "size", -1, "max unacked messages per consumer")
+		_ = cobra.MarkFlagRequired(set, "size")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetMaxUnackedMessagesPerConsumer(vc *cmdutils.VerbCmd, max int) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if max < 0 {
+		return errors.New("the max unacked messages per consumer can not be negative")
+	}
+
+	err = setIntPolicy(ns, "maxUnackedMessagesPerConsumer", max)
+	if err == nil {
+		vc.Command.Printf("Successfully set the max unacked messages per consumer of the namespace %s to %d\n",
+			ns.String(), max)
+	}
+
+	return err
+}
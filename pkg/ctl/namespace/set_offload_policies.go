@@ -0,0 +1,91 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func SetOffloadPoliciesCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Set the offload policies of a namespace"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	examples = append(examples, cmdutils.Example{
+		Desc: "Offload the topics of a namespace to S3 once they hold more than 10 GB",
+		Command: "pulsarctl namespaces set-offload-policies tenant/namespace --driver aws-s3 " +
+			"--bucket bucket --region us-west-2 --threshold 10g",
+	})
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set offload policies successfully for [tenant/namespace]",
+	}
+	invalidDriver := cmdutils.Output{
+		Desc: "the offload driver is invalid",
+		Out: "[✖]  invalid offload driver '<driver>', valid drivers are " +
+			"S3, aws-s3, google-cloud-storage, filesystem, azureblob, aliyun-oss",
+	}
+	out = append(out, successOut, ArgError, invalidDriver, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-offload-policies",
+		desc.CommandUsedFor,
+		desc.ToString(),
+		desc.ExampleToString())
+
+	flags := &ctlutils.OffloadPolicyFlags{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetOffloadPolicies(vc, flags)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Offload Policies", func(set *pflag.FlagSet) {
+		flags.AddTo(set)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetOffloadPolicies(vc *cmdutils.VerbCmd, flags *ctlutils.OffloadPolicyFlags) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	policies, err := flags.Policies()
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.Post(rc.Endpoint("/namespaces", ns.String(), "offloadPolicies"), policies)
+	if err == nil {
+		vc.Command.Printf("Set offload policies successfully for [%s]\n", ns.String())
+	}
+
+	return err
+}
@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetResourceGroupCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the resource group of a namespace, " +
+		"the namespace shares the rate limits of the resource group with the other namespaces using it."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	set := cmdutils.Example{
+		Desc:    "Set the resource group of the namespace (namespace-name) to (resource-group)",
+		Command: "pulsarctl namespaces set-resource-group --resource-group (resource-group) (namespace-name)",
+	}
+	examples = append(examples, set)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the resource group of the namespace (namespace-name) to (resource-group)",
+	}
+
+	rgNotExistError := cmdutils.Output{
+		Desc: "the resource group does not exist",
+		Out:  "[✖]  code: 404 reason: ResourceGroup does not exist",
+	}
+	out = append(out, successOut, rgNotExistError, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-resource-group",
+		"Set the resource group of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var rg string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetResourceGroup(vc, rg)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Resource Group", func(set *pflag.FlagSet) {
+		set.StringVarP(&rg, "resource-group", "r", "", "the name of the resource group")
+		_ = cobra.MarkFlagRequired(set, "resource-group")
+	})
+}
+
+func doSetResourceGroup(vc *cmdutils.VerbCmd, rg string) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.Post(rc.Endpoint("/namespaces", ns.String(), "resourcegroup", rg), nil)
+	if err == nil {
+		vc.Command.Printf("Successfully set the resource group of the namespace %s to %s\n", ns.String(), rg)
+	}
+	return err
+}
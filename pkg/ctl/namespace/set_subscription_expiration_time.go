@@ -0,0 +1,102 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package namespace
+
+import (
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetSubscriptionExpirationTimeCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for setting the subscription expiration time of a namespace. " +
+		"A subscription which has been inactive for longer than the expiration time is deleted automatically, 0 means never."
+	desc.CommandPermission = "This command requires super-user permissions and broker has write policies permission."
+
+	var examples []cmdutils.Example
+	set := cmdutils.Example{
+		Desc:    "Set the subscription expiration time of the namespace (namespace-name) to 120 minutes",
+		Command: "pulsarctl namespaces set-subscription-expiration-time --time 120 (namespace-name)",
+	}
+
+	setRelative := cmdutils.Example{
+		Desc:    "Set the subscription expiration time of the namespace (namespace-name) to 2h",
+		Command: "pulsarctl namespaces set-subscription-expiration-time --time 2h (namespace-name)",
+	}
+	examples = append(examples, set, setRelative)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Successfully set the subscription expiration time of the namespace (namespace-name) to (time) minutes",
+	}
+
+	invalidTimeOut := cmdutils.Output{
+		Desc: "the specified time is negative",
+		Out:  "[✖]  the time (time) can not be negative",
+	}
+	out = append(out, successOut, invalidTimeOut, ArgError, NsNotExistError)
+	out = append(out, NsErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-subscription-expiration-time",
+		"Set the subscription expiration time of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var t string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSetSubscriptionExpirationTime(vc, t)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Subscription Expiration Time", func(set *pflag.FlagSet) {
+		set.StringVarP(&t, "time", "t", "",
+			"subscription expiration time in minutes, or a relative time (eg: 2h)")
+		_ = cobra.MarkFlagRequired(set, "time")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetSubscriptionExpirationTime(vc *cmdutils.VerbCmd, t string) error {
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	minutes, err := parseTimeInUnit(t, time.Minute)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Namespaces().SetSubscriptionExpirationTime(*ns, minutes)
+	if err == nil {
+		vc.Command.Printf("Successfully set the subscription expiration time of the namespace %s to %d minutes\n",
+			ns.String(), minutes)
+	}
+
+	return err
+}
@@ -0,0 +1,215 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package perf
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+type consumeOptions struct {
+	subscription      string
+	subscriptionType  string
+	consumers         int
+	receiverQueueSize int
+	numMessages       int64
+	duration          time.Duration
+	interval          time.Duration
+	serviceURL        string
+}
+
+func ConsumeCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for consuming messages from one or more topics and measuring " +
+		"the consume throughput and the end to end latency, which is the time between the publish time of a " +
+		"message and its receipt. The rate and the latency percentiles of each interval are reported on stderr, " +
+		"and a summary of the whole run is printed at the end, in JSON with `-o json` for comparing runs in CI. " +
+		"The run lasts until the duration or the number of messages is reached, or until it is interrupted."
+	desc.CommandPermission = "This command requires namespace consume permissions."
+
+	var examples []cmdutils.Example
+	consume := cmdutils.Example{
+		Desc:    "Consume the messages of a topic (topic-name) for one minute",
+		Command: "pulsarctl perf consume (topic-name) --duration 1m",
+	}
+	consumeShared := cmdutils.Example{
+		Desc: "Consume the messages of two topics with 4 consumers per topic on a shared subscription " +
+			"and print the summary in JSON",
+		Command: "pulsarctl perf consume (topic-name-1) (topic-name-2) --consumers 4 " +
+			"--subscription-type shared -o json",
+	}
+	examples = append(examples, consume, consumeShared)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Consume rate:   1000.0 msg/s -    7.81 Mbps - errors: 0 - latency ms: " +
+			"50% 3.10 - 95% 4.52 - 99% 6.01 - 99.9% 10.80 - max 13.30\n" +
+			"Messages: 60000 - Bytes: 61440000 - Errors: 0 - Duration: 60.0s\n" +
+			"Rate: 1000.0 msg/s - Throughput: 7.81 Mbps\n" +
+			"Latency ms: mean 3.20 - 50% 3.10 - 95% 4.52 - 99% 6.01 - 99.9% 10.80 - max 13.30",
+	}
+	argError := cmdutils.Output{
+		Desc: "no topic name is specified",
+		Out:  "[✖]  at least one topic name must be specified",
+	}
+	subTypeError := cmdutils.Output{
+		Desc: "the subscription type is invalid",
+		Out:  "[✖]  invalid subscription type 'unknown', it should be one of exclusive, shared, failover and key_shared",
+	}
+	out = append(out, successOut, argError, subTypeError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"consume",
+		"Consume messages from topics and measure the performance",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	opts := consumeOptions{}
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doConsume(vc, &opts)
+	}, checkTopicArgs)
+
+	vc.FlagSetGroup.InFlagSet("Consume", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.subscription, "subscription", "pulsarctl-perf", "The subscription name")
+		set.StringVar(&opts.subscriptionType, "subscription-type", "exclusive",
+			"The subscription type: exclusive, shared, failover or key_shared")
+		set.IntVar(&opts.consumers, "consumers", 1, "The number of consumers per topic")
+		set.IntVar(&opts.receiverQueueSize, "receiver-queue-size", 1000, "The receiver queue size of a consumer")
+		set.Int64VarP(&opts.numMessages, "num-messages", "m", 0,
+			"The total number of messages to consume, 0 means no limit")
+		set.DurationVarP(&opts.duration, "duration", "d", 0, "The duration of the run, 0 means no limit")
+		set.DurationVarP(&opts.interval, "interval", "i", 10*time.Second,
+			"The interval of reporting the rate and the latencies, 0 disables the report")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doConsume(vc *cmdutils.VerbCmd, opts *consumeOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	subType, err := parseSubscriptionType(opts.subscriptionType)
+	if err != nil {
+		return err
+	}
+	if opts.consumers <= 0 {
+		return errors.New("the number of consumers must be greater than 0")
+	}
+	if subType == pulsar.Exclusive && opts.consumers > 1 {
+		return errors.New("an exclusive subscription allows only one consumer per topic")
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var consumers []pulsar.Consumer
+	defer func() {
+		for _, c := range consumers {
+			c.Close()
+		}
+	}()
+	for _, topic := range vc.NameArgs {
+		for i := 0; i < opts.consumers; i++ {
+			c, err := client.Subscribe(pulsar.ConsumerOptions{
+				Topic:             topic,
+				SubscriptionName:  opts.subscription,
+				Type:              subType,
+				ReceiverQueueSize: opts.receiverQueueSize,
+			})
+			if err != nil {
+				return err
+			}
+			consumers = append(consumers, c)
+		}
+	}
+
+	ctx, cancel := runContext(opts.duration)
+	defer cancel()
+
+	r := newRecorder()
+	go reportLoop(ctx, r, vc.Command.ErrOrStderr(), "Consume", opts.interval)
+
+	var received int64
+	var wg sync.WaitGroup
+	for _, c := range consumers {
+		wg.Add(1)
+		go func(c pulsar.Consumer) {
+			defer wg.Done()
+			for {
+				msg, err := c.Receive(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						r.recordError()
+					}
+					return
+				}
+				r.record(len(msg.Payload()), time.Since(msg.PublishTime()))
+				if err := c.Ack(msg); err != nil {
+					r.recordError()
+				}
+				if opts.numMessages > 0 && atomic.AddInt64(&received, 1) >= opts.numMessages {
+					cancel()
+					return
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	s := r.summary(vc.NameArgs)
+	oc := cmdutils.NewOutputContent().
+		WithObject(s).
+		WithTextFunc(func(w io.Writer) error {
+			return writeSummaryText(w, s)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func parseSubscriptionType(s string) (pulsar.SubscriptionType, error) {
+	switch strings.ToLower(s) {
+	case "exclusive":
+		return pulsar.Exclusive, nil
+	case "shared":
+		return pulsar.Shared, nil
+	case "failover":
+		return pulsar.Failover, nil
+	case "key_shared", "key-shared":
+		return pulsar.KeyShared, nil
+	}
+	return pulsar.Exclusive, errors.Errorf(
+		"invalid subscription type '%s', it should be one of exclusive, shared, failover and key_shared", s)
+}
@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package perf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	r := newRecorder()
+	for i := 1; i <= 100; i++ {
+		r.record(10, time.Duration(i)*time.Millisecond)
+	}
+	r.recordError()
+
+	var buf bytes.Buffer
+	r.report(&buf, "Produce")
+	assert.Contains(t, buf.String(), "Produce rate:")
+	assert.Contains(t, buf.String(), "errors: 1")
+
+	s := r.summary([]string{"my-topic"})
+	assert.Equal(t, []string{"my-topic"}, s.Topics)
+	assert.Equal(t, int64(100), s.Messages)
+	assert.Equal(t, int64(1000), s.Bytes)
+	assert.Equal(t, int64(1), s.Errors)
+	assert.InDelta(t, 50, s.Latency.P50, 0.1)
+	assert.InDelta(t, 99, s.Latency.P99, 0.1)
+	assert.InDelta(t, 100, s.Latency.Max, 0.1)
+	assert.InDelta(t, 50.5, s.Latency.Mean, 0.1)
+
+	// the interval is reset by a report, the summary covers the whole run
+	r.record(10, time.Millisecond)
+	buf.Reset()
+	r.report(&buf, "Produce")
+	assert.Contains(t, buf.String(), "max    1.00")
+	assert.Equal(t, int64(101), r.summary(nil).Messages)
+}
+
+func TestParseSubscriptionType(t *testing.T) {
+	for s, want := range map[string]pulsar.SubscriptionType{
+		"exclusive":  pulsar.Exclusive,
+		"Shared":     pulsar.Shared,
+		"failover":   pulsar.Failover,
+		"key_shared": pulsar.KeyShared,
+	} {
+		subType, err := parseSubscriptionType(s)
+		assert.Nil(t, err)
+		assert.Equal(t, want, subType)
+	}
+
+	_, err := parseSubscriptionType("unknown")
+	assert.NotNil(t, err)
+}
+
+func TestProduceArgsError(t *testing.T) {
+	_, _, nameErr, _ := testPerfCommands(ProduceCmd, []string{"produce"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "at least one topic name must be specified", nameErr.Error())
+}
+
+func TestConsumeArgsError(t *testing.T) {
+	_, _, nameErr, _ := testPerfCommands(ConsumeCmd, []string{"consume"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "at least one topic name must be specified", nameErr.Error())
+}
+
+func TestConsumeInvalidSubscriptionType(t *testing.T) {
+	_, execErr, _, _ := testPerfCommands(ConsumeCmd, []string{"consume", "my-topic", "--subscription-type", "unknown"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid subscription type 'unknown', it should be one of exclusive, shared, failover "+
+		"and key_shared", execErr.Error())
+}
+
+func TestConsumeExclusiveWithConsumers(t *testing.T) {
+	_, execErr, _, _ := testPerfCommands(ConsumeCmd, []string{"consume", "my-topic", "--consumers", "2"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "an exclusive subscription allows only one consumer per topic", execErr.Error())
+}
@@ -0,0 +1,231 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package perf
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+const flushTimeout = 30 * time.Second
+
+type produceOptions struct {
+	rate                int
+	size                int
+	producers           int
+	numMessages         int64
+	duration            time.Duration
+	interval            time.Duration
+	disableBatching     bool
+	batchingMaxDelay    time.Duration
+	batchingMaxMessages uint
+	batchingMaxSize     uint
+	maxPendingMessages  int
+	serviceURL          string
+}
+
+func ProduceCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for producing messages to one or more topics at a given rate " +
+		"and measuring the publish throughput and latency. The rate and the latency percentiles of each " +
+		"interval are reported on stderr, and a summary of the whole run is printed at the end, in JSON " +
+		"with `-o json` for comparing runs in CI. The run lasts until the duration or the number of messages " +
+		"is reached, or until it is interrupted."
+	desc.CommandPermission = "This command requires namespace produce permissions."
+
+	var examples []cmdutils.Example
+	produce := cmdutils.Example{
+		Desc:    "Produce 1000 messages per second of 1 KB to a topic (topic-name) for one minute",
+		Command: "pulsarctl perf produce (topic-name) --rate 1000 --size 1024 --duration 1m",
+	}
+	produceMulti := cmdutils.Example{
+		Desc: "Produce to two topics with 4 producers per topic without a rate limit and " +
+			"print the summary in JSON",
+		Command: "pulsarctl perf produce (topic-name-1) (topic-name-2) --producers 4 --rate 0 " +
+			"--num-messages 1000000 -o json",
+	}
+	produceNoBatching := cmdutils.Example{
+		Desc:    "Produce to a topic (topic-name) without batching",
+		Command: "pulsarctl perf produce (topic-name) --disable-batching",
+	}
+	examples = append(examples, produce, produceMulti, produceNoBatching)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Produce rate:   1000.0 msg/s -    7.81 Mbps - errors: 0 - latency ms: " +
+			"50% 2.10 - 95% 3.52 - 99% 5.01 - 99.9% 9.80 - max 12.30\n" +
+			"Messages: 60000 - Bytes: 61440000 - Errors: 0 - Duration: 60.0s\n" +
+			"Rate: 1000.0 msg/s - Throughput: 7.81 Mbps\n" +
+			"Latency ms: mean 2.20 - 50% 2.10 - 95% 3.52 - 99% 5.01 - 99.9% 9.80 - max 12.30",
+	}
+	argError := cmdutils.Output{
+		Desc: "no topic name is specified",
+		Out:  "[✖]  at least one topic name must be specified",
+	}
+	out = append(out, successOut, argError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"produce",
+		"Produce messages to topics and measure the performance",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	opts := produceOptions{}
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doProduce(vc, &opts)
+	}, checkTopicArgs)
+
+	vc.FlagSetGroup.InFlagSet("Produce", func(set *pflag.FlagSet) {
+		set.IntVarP(&opts.rate, "rate", "r", 100, "The total publish rate in msg/s, 0 means no limit")
+		set.IntVar(&opts.size, "size", 1024, "The size of each message in bytes")
+		set.IntVar(&opts.producers, "producers", 1, "The number of producers per topic")
+		set.Int64VarP(&opts.numMessages, "num-messages", "m", 0,
+			"The total number of messages to produce, 0 means no limit")
+		set.DurationVarP(&opts.duration, "duration", "d", 0, "The duration of the run, 0 means no limit")
+		set.DurationVarP(&opts.interval, "interval", "i", 10*time.Second,
+			"The interval of reporting the rate and the latencies, 0 disables the report")
+		set.BoolVar(&opts.disableBatching, "disable-batching", false, "Disable batching")
+		set.DurationVar(&opts.batchingMaxDelay, "batching-max-publish-delay", 10*time.Millisecond,
+			"The maximum time to wait for a batch to fill")
+		set.UintVar(&opts.batchingMaxMessages, "batching-max-messages", 1000,
+			"The maximum number of messages in a batch")
+		set.UintVar(&opts.batchingMaxSize, "batching-max-size", 128*1024,
+			"The maximum size of a batch in bytes")
+		set.IntVar(&opts.maxPendingMessages, "max-pending-messages", 1000,
+			"The maximum number of messages pending for a publish acknowledgement per producer")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doProduce(vc *cmdutils.VerbCmd, opts *produceOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if opts.producers <= 0 {
+		return errors.New("the number of producers must be greater than 0")
+	}
+	if opts.size <= 0 {
+		return errors.New("the message size must be greater than 0")
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var producers []pulsar.Producer
+	defer func() {
+		for _, p := range producers {
+			p.Close()
+		}
+	}()
+	for _, topic := range vc.NameArgs {
+		for i := 0; i < opts.producers; i++ {
+			p, err := client.CreateProducer(pulsar.ProducerOptions{
+				Topic:                   topic,
+				DisableBatching:         opts.disableBatching,
+				BatchingMaxPublishDelay: opts.batchingMaxDelay,
+				BatchingMaxMessages:     opts.batchingMaxMessages,
+				BatchingMaxSize:         opts.batchingMaxSize,
+				MaxPendingMessages:      opts.maxPendingMessages,
+			})
+			if err != nil {
+				return err
+			}
+			producers = append(producers, p)
+		}
+	}
+
+	payload := make([]byte, opts.size)
+	// random content is not compressed away
+	_, _ = rand.New(rand.NewSource(time.Now().UnixNano())).Read(payload)
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if opts.rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.rate), opts.rate)
+	}
+
+	ctx, cancel := runContext(opts.duration)
+	defer cancel()
+
+	r := newRecorder()
+	go reportLoop(ctx, r, vc.Command.ErrOrStderr(), "Produce", opts.interval)
+
+	var sent int64
+	var wg sync.WaitGroup
+	for _, p := range producers {
+		wg.Add(1)
+		go func(p pulsar.Producer) {
+			defer wg.Done()
+			for {
+				if err := limiter.Wait(ctx); err != nil {
+					return
+				}
+				if opts.numMessages > 0 && atomic.AddInt64(&sent, 1) > opts.numMessages {
+					return
+				}
+				start := time.Now()
+				p.SendAsync(context.Background(), &pulsar.ProducerMessage{Payload: payload},
+					func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+						if err != nil {
+							r.recordError()
+							return
+						}
+						r.record(len(payload), time.Since(start))
+					})
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	// wait for the messages pending for a publish acknowledgement
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancelFlush()
+	for _, p := range producers {
+		if err := p.FlushWithCtx(flushCtx); err != nil {
+			return err
+		}
+	}
+
+	s := r.summary(vc.NameArgs)
+	oc := cmdutils.NewOutputContent().
+		WithObject(s).
+		WithTextFunc(func(w io.Writer) error {
+			return writeSummaryText(w, s)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
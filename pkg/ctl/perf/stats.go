@@ -0,0 +1,184 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/pulsar/latency"
+)
+
+// Summary is the result of a perf run, the latencies are in milliseconds
+type Summary struct {
+	Topics         []string        `json:"topics"`
+	Messages       int64           `json:"messages"`
+	Bytes          int64           `json:"bytes"`
+	Errors         int64           `json:"errors"`
+	DurationSecond float64         `json:"durationSeconds"`
+	MessageRate    float64         `json:"msgRate"`
+	ThroughputMbps float64         `json:"throughputMbps"`
+	Latency        latency.Summary `json:"latencyMs"`
+}
+
+// recorder records the messages and their latencies of a run, both for the current
+// report interval and the whole run
+type recorder struct {
+	mu       sync.Mutex
+	start    time.Time
+	last     time.Time
+	messages int64
+	bytes    int64
+	errors   int64
+	total    *hdrhistogram.Histogram
+	interval *hdrhistogram.Histogram
+	intMsgs  int64
+	intBytes int64
+}
+
+func newRecorder() *recorder {
+	now := time.Now()
+	return &recorder{
+		start:    now,
+		last:     now,
+		total:    latency.NewHistogram(),
+		interval: latency.NewHistogram(),
+	}
+}
+
+func (r *recorder) record(size int, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages++
+	r.bytes += int64(size)
+	r.intMsgs++
+	r.intBytes += int64(size)
+	latency.Record(r.total, d)
+	latency.Record(r.interval, d)
+}
+
+func (r *recorder) recordError() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors++
+}
+
+func (r *recorder) count() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.messages
+}
+
+// report writes the rate and the latencies of the current interval and starts a new one
+func (r *recorder) report(w io.Writer, verb string) {
+	r.mu.Lock()
+	now := time.Now()
+	elapsed := now.Sub(r.last).Seconds()
+	msgs, bytes, errs := r.intMsgs, r.intBytes, r.errors
+	l := latency.Summarize(r.interval)
+	r.interval.Reset()
+	r.intMsgs, r.intBytes, r.last = 0, 0, now
+	r.mu.Unlock()
+
+	if elapsed <= 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(w,
+		"%s rate: %8.1f msg/s - %7.2f Mbps - errors: %d - latency ms: "+
+			"50%% %7.2f - 95%% %7.2f - 99%% %7.2f - 99.9%% %7.2f - max %7.2f\n",
+		verb, float64(msgs)/elapsed, float64(bytes)*8/elapsed/1024/1024, errs,
+		l.P50, l.P95, l.P99, l.P999, l.Max)
+}
+
+func (r *recorder) summary(topics []string) *Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := &Summary{
+		Topics:         topics,
+		Messages:       r.messages,
+		Bytes:          r.bytes,
+		Errors:         r.errors,
+		DurationSecond: time.Since(r.start).Seconds(),
+		Latency:        latency.Summarize(r.total),
+	}
+	if s.DurationSecond > 0 {
+		s.MessageRate = float64(s.Messages) / s.DurationSecond
+		s.ThroughputMbps = float64(s.Bytes) * 8 / s.DurationSecond / 1024 / 1024
+	}
+	return s
+}
+
+// runContext returns a context that is done when the run lasts the duration or
+// the command is interrupted
+func runContext(duration time.Duration) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	if duration <= 0 {
+		return ctx, stop
+	}
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// reportLoop reports the progress of the run on every interval until the context is done
+func reportLoop(ctx context.Context, r *recorder, w io.Writer, verb string, interval time.Duration) {
+	if interval <= 0 {
+		<-ctx.Done()
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.report(w, verb)
+		}
+	}
+}
+
+// checkTopicArgs checks at least one topic is specified
+func checkTopicArgs(args []string) error {
+	if len(args) == 0 {
+		return errors.New("at least one topic name must be specified")
+	}
+	return nil
+}
+
+func writeSummaryText(w io.Writer, s *Summary) error {
+	_, err := fmt.Fprintf(w,
+		"Messages: %d - Bytes: %d - Errors: %d - Duration: %.1fs\n"+
+			"Rate: %.1f msg/s - Throughput: %.2f Mbps\n"+
+			"Latency ms: mean %.2f - 50%% %.2f - 95%% %.2f - 99%% %.2f - 99.9%% %.2f - max %.2f\n",
+		s.Messages, s.Bytes, s.Errors, s.DurationSecond, s.MessageRate, s.ThroughputMbps,
+		s.Latency.Mean, s.Latency.P50, s.Latency.P95, s.Latency.P99, s.Latency.P999, s.Latency.Max)
+	return err
+}
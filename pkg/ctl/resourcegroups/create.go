@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func createCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Create a resource group, the publish and dispatch rate limits of a resource group " +
+		"are shared by all the namespaces and tenants using it. A limit which is not specified is unlimited."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	create := cmdutils.Example{
+		Desc: "Create the resource group (name) which allows publishing 1000 messages and 1MB per second",
+		Command: "pulsarctl resource-groups create (name) " +
+			"--msg-publish-rate 1000 --byte-publish-rate 1048576",
+	}
+	examples = append(examples, create)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Created resource group (name) successfully",
+	}
+
+	existError := cmdutils.Output{
+		Desc: "the resource group already exists",
+		Out:  "[✖]  the resource group (name) already exists",
+	}
+	out = append(out, successOut, nameArgError, existError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"create",
+		"Create a resource group",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"create")
+
+	flags := &rateFlags{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doCreate(vc, flags)
+	}, "the resource group name is not specified or the resource group name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Resource Group", func(set *pflag.FlagSet) {
+		flags.addTo(set)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doCreate(vc *cmdutils.VerbCmd, flags *rateFlags) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	rg, err := flags.resourceGroup(vc.Command.Flags())
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	// the broker creates or updates a resource group with the same request
+	_, err = getResourceGroup(rc, vc.NameArg)
+	switch {
+	case err == nil:
+		return errors.Errorf("the resource group %s already exists", vc.NameArg)
+	case !isNotFound(err):
+		return err
+	}
+
+	err = rc.Put(resourceGroupEndpoint(rc, vc.NameArg), rg)
+	if err == nil {
+		vc.Command.Printf("Created resource group %s successfully\n", vc.NameArg)
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func deleteCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Delete a resource group, a resource group which is still used by a namespace " +
+		"or a tenant can not be deleted."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	del := cmdutils.Example{
+		Desc:    "Delete the resource group (name)",
+		Command: "pulsarctl resource-groups delete (name)",
+	}
+	examples = append(examples, del)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Deleted resource group (name) successfully",
+	}
+
+	inUseError := cmdutils.Output{
+		Desc: "the resource group is still used by a namespace or a tenant",
+		Out:  "[✖]  code: 412 reason: ResourceGroup is in use",
+	}
+	out = append(out, successOut, nameArgError, notFoundError, inUseError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"delete",
+		"Delete a resource group",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"delete")
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doDelete(vc)
+	}, "the resource group name is not specified or the resource group name is specified more than one")
+}
+
+func doDelete(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err := rc.Delete(resourceGroupEndpoint(rc, vc.NameArg))
+	if err == nil {
+		vc.Command.Printf("Deleted resource group %s successfully\n", vc.NameArg)
+	}
+	return err
+}
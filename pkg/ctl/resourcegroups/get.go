@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"io"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func getCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the rate limits of a resource group"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	get := cmdutils.Example{
+		Desc:    "Get the rate limits of the resource group (name)",
+		Command: "pulsarctl resource-groups get (name)",
+	}
+
+	getJSON := cmdutils.Example{
+		Desc:    "Get the rate limits of the resource group (name) in json",
+		Command: "pulsarctl resource-groups get (name) -o json",
+	}
+	examples = append(examples, get, getJSON)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+----------------+------------------+-------------------+-------------------+--------------------+\n" +
+			"| RESOURCE GROUP | MSG PUBLISH RATE | BYTE PUBLISH RATE | MSG DISPATCH RATE | BYTE DISPATCH RATE |\n" +
+			"+----------------+------------------+-------------------+-------------------+--------------------+\n" +
+			"| (name)         |             1000 |           1048576 | unlimited         | unlimited          |\n" +
+			"+----------------+------------------+-------------------+-------------------+--------------------+",
+	}
+	out = append(out, successOut, nameArgError, notFoundError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get",
+		"Get a resource group",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"get")
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGet(vc)
+	}, "the resource group name is not specified or the resource group name is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doGet(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	rg, err := getResourceGroup(rc, vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(rg).
+		WithTextFunc(func(w io.Writer) error {
+			return writeResourceGroup(w, vc.NameArg, rg)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
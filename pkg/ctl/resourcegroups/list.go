@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"io"
+	"sort"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func listCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "List the resource groups"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	list := cmdutils.Example{
+		Desc:    "List the resource groups",
+		Command: "pulsarctl resource-groups list",
+	}
+	examples = append(examples, list)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+----------------+\n" +
+			"| RESOURCE GROUP |\n" +
+			"+----------------+\n" +
+			"| (name)         |\n" +
+			"+----------------+",
+	}
+	out = append(out, successOut)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"list",
+		"List the resource groups",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"list")
+
+	vc.SetRunFunc(func() error {
+		return doList(vc)
+	})
+
+	vc.EnableOutputFlagSet()
+}
+
+func doList(vc *cmdutils.VerbCmd) error {
+	rc := cmdutils.NewPulsarRestClient()
+	var names []string
+	if err := rc.Get(resourceGroupEndpoint(rc), &names); err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(names).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Resource Group"})
+			for _, name := range names {
+				table.Append([]string{name})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
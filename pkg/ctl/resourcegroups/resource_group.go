@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+var nameArgError = cmdutils.Output{
+	Desc: "the resource group name is not specified or the resource group name is specified more than one",
+	Out:  "[✖]  the resource group name is not specified or the resource group name is specified more than one",
+}
+
+var notFoundError = cmdutils.Output{
+	Desc: "the resource group does not exist",
+	Out:  "[✖]  code: 404 reason: ResourceGroup does not exist",
+}
+
+// ResourceGroup is the rate limits shared by the namespaces and tenants using the
+// resource group, a limit which is not set is omitted so it is kept as is on update
+type ResourceGroup struct {
+	PublishRateInMsgs   *int64 `json:"publishRateInMsgs,omitempty"`
+	PublishRateInBytes  *int64 `json:"publishRateInBytes,omitempty"`
+	DispatchRateInMsgs  *int64 `json:"dispatchRateInMsgs,omitempty"`
+	DispatchRateInBytes *int64 `json:"dispatchRateInBytes,omitempty"`
+}
+
+type rateFlags struct {
+	publishRateInMsgs   int64
+	publishRateInBytes  int64
+	dispatchRateInMsgs  int64
+	dispatchRateInBytes int64
+}
+
+func (f *rateFlags) addTo(set *pflag.FlagSet) {
+	set.Int64Var(&f.publishRateInMsgs, "msg-publish-rate", -1,
+		"the max number of messages published per second, -1 means no limit")
+	set.Int64Var(&f.publishRateInBytes, "byte-publish-rate", -1,
+		"the max number of bytes published per second, -1 means no limit")
+	set.Int64Var(&f.dispatchRateInMsgs, "msg-dispatch-rate", -1,
+		"the max number of messages dispatched per second, -1 means no limit")
+	set.Int64Var(&f.dispatchRateInBytes, "byte-dispatch-rate", -1,
+		"the max number of bytes dispatched per second, -1 means no limit")
+}
+
+// resourceGroup returns the resource group with the limits whose flags are specified
+func (f *rateFlags) resourceGroup(set *pflag.FlagSet) (*ResourceGroup, error) {
+	rg := &ResourceGroup{}
+	limits := []struct {
+		flag  string
+		value int64
+		field **int64
+	}{
+		{"msg-publish-rate", f.publishRateInMsgs, &rg.PublishRateInMsgs},
+		{"byte-publish-rate", f.publishRateInBytes, &rg.PublishRateInBytes},
+		{"msg-dispatch-rate", f.dispatchRateInMsgs, &rg.DispatchRateInMsgs},
+		{"byte-dispatch-rate", f.dispatchRateInBytes, &rg.DispatchRateInBytes},
+	}
+	for _, l := range limits {
+		if !set.Changed(l.flag) {
+			continue
+		}
+		if l.value < -1 {
+			return nil, errors.New("the " + l.flag + " should be -1 or a positive number")
+		}
+		v := l.value
+		*l.field = &v
+	}
+	return rg, nil
+}
+
+func (rg *ResourceGroup) isEmpty() bool {
+	return rg.PublishRateInMsgs == nil && rg.PublishRateInBytes == nil &&
+		rg.DispatchRateInMsgs == nil && rg.DispatchRateInBytes == nil
+}
+
+func resourceGroupEndpoint(rc *cmdutils.RestClient, name ...string) string {
+	return rc.Endpoint("/resourcegroups", name...)
+}
+
+func getResourceGroup(rc *cmdutils.RestClient, name string) (*ResourceGroup, error) {
+	var rg ResourceGroup
+	err := rc.Get(resourceGroupEndpoint(rc, name), &rg)
+	if err != nil {
+		return nil, err
+	}
+	return &rg, nil
+}
+
+func isNotFound(err error) bool {
+	var restErr rest.Error
+	return errors.As(err, &restErr) && restErr.Code == http.StatusNotFound
+}
+
+func writeResourceGroup(w io.Writer, name string, rg *ResourceGroup) error {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Resource Group", "Msg Publish Rate", "Byte Publish Rate",
+		"Msg Dispatch Rate", "Byte Dispatch Rate"})
+	table.Append([]string{name, formatLimit(rg.PublishRateInMsgs), formatLimit(rg.PublishRateInBytes),
+		formatLimit(rg.DispatchRateInMsgs), formatLimit(rg.DispatchRateInBytes)})
+	table.Render()
+	return nil
+}
+
+func formatLimit(v *int64) string {
+	if v == nil || *v < 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(*v, 10)
+}
@@ -0,0 +1,125 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateFlags(t *testing.T) {
+	flags := &rateFlags{}
+	set := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.addTo(set)
+	require.Nil(t, set.Parse([]string{"--msg-publish-rate", "100", "--byte-dispatch-rate", "-1"}))
+
+	rg, err := flags.resourceGroup(set)
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), *rg.PublishRateInMsgs)
+	assert.Equal(t, int64(-1), *rg.DispatchRateInBytes)
+	assert.Nil(t, rg.PublishRateInBytes)
+	assert.Nil(t, rg.DispatchRateInMsgs)
+	assert.False(t, rg.isEmpty())
+
+	body, err := json.Marshal(rg)
+	require.Nil(t, err)
+	assert.Equal(t, `{"publishRateInMsgs":100,"dispatchRateInBytes":-1}`, string(body))
+
+	flags = &rateFlags{}
+	set = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.addTo(set)
+	require.Nil(t, set.Parse(nil))
+	rg, err = flags.resourceGroup(set)
+	require.Nil(t, err)
+	assert.True(t, rg.isEmpty())
+
+	flags = &rateFlags{}
+	set = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.addTo(set)
+	require.Nil(t, set.Parse([]string{"--msg-dispatch-rate", "-2"}))
+	_, err = flags.resourceGroup(set)
+	assert.EqualError(t, err, "the msg-dispatch-rate should be -1 or a positive number")
+}
+
+func TestFormatLimit(t *testing.T) {
+	limit := int64(10)
+	unlimited := int64(-1)
+	assert.Equal(t, "10", formatLimit(&limit))
+	assert.Equal(t, "unlimited", formatLimit(&unlimited))
+	assert.Equal(t, "unlimited", formatLimit(nil))
+}
+
+func TestResourceGroupCommands(t *testing.T) {
+	name := "test-resource-group"
+	args := []string{"create", name, "--msg-publish-rate", "1000", "--byte-publish-rate", "1048576"}
+	out, execErr, _, _ := TestResourceGroupsCommands(createCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, "Created resource group "+name+" successfully\n", out.String())
+
+	args = []string{"create", name}
+	_, execErr, _, _ = TestResourceGroupsCommands(createCmd, args)
+	assert.EqualError(t, execErr, "the resource group "+name+" already exists")
+
+	args = []string{"update", name, "--msg-dispatch-rate", "2000"}
+	out, execErr, _, _ = TestResourceGroupsCommands(updateCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, "Updated resource group "+name+" successfully\n", out.String())
+
+	args = []string{"get", name, "-o", "json"}
+	out, execErr, _, _ = TestResourceGroupsCommands(getCmd, args)
+	require.Nil(t, execErr)
+	var rg ResourceGroup
+	require.Nil(t, json.Unmarshal(out.Bytes(), &rg))
+	assert.Equal(t, int64(1000), *rg.PublishRateInMsgs)
+	assert.Equal(t, int64(1048576), *rg.PublishRateInBytes)
+	assert.Equal(t, int64(2000), *rg.DispatchRateInMsgs)
+
+	args = []string{"list", "-o", "json"}
+	out, execErr, _, _ = TestResourceGroupsCommands(listCmd, args)
+	require.Nil(t, execErr)
+	var names []string
+	require.Nil(t, json.Unmarshal(out.Bytes(), &names))
+	assert.Contains(t, names, name)
+
+	args = []string{"delete", name}
+	out, execErr, _, _ = TestResourceGroupsCommands(deleteCmd, args)
+	require.Nil(t, execErr)
+	assert.Equal(t, "Deleted resource group "+name+" successfully\n", out.String())
+
+	args = []string{"get", name}
+	_, execErr, _, _ = TestResourceGroupsCommands(getCmd, args)
+	assert.NotNil(t, execErr)
+	assert.True(t, isNotFound(execErr))
+}
+
+func TestUpdateResourceGroupWithoutLimits(t *testing.T) {
+	args := []string{"update", "test-resource-group"}
+	_, execErr, _, _ := TestResourceGroupsCommands(updateCmd, args)
+	assert.EqualError(t, execErr, "at least one rate limit to update should be specified")
+}
+
+func TestResourceGroupNameArgError(t *testing.T) {
+	args := []string{"get"}
+	_, _, nameErr, _ := TestResourceGroupsCommands(getCmd, args)
+	assert.EqualError(t, nameErr,
+		"the resource group name is not specified or the resource group name is specified more than one")
+}
@@ -0,0 +1,103 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package resourcegroups
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func updateCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Update the rate limits of a resource group, the limits which are not specified " +
+		"are kept as is."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	update := cmdutils.Example{
+		Desc:    "Update the resource group (name) to allow dispatching 2000 messages per second",
+		Command: "pulsarctl resource-groups update (name) --msg-dispatch-rate 2000",
+	}
+
+	unlimited := cmdutils.Example{
+		Desc:    "Remove the limit of bytes published per second from the resource group (name)",
+		Command: "pulsarctl resource-groups update (name) --byte-publish-rate -1",
+	}
+	examples = append(examples, update, unlimited)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Updated resource group (name) successfully",
+	}
+
+	noLimitError := cmdutils.Output{
+		Desc: "no rate limit is specified",
+		Out:  "[✖]  at least one rate limit to update should be specified",
+	}
+	out = append(out, successOut, nameArgError, noLimitError, notFoundError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"update",
+		"Update a resource group",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"update")
+
+	flags := &rateFlags{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doUpdate(vc, flags)
+	}, "the resource group name is not specified or the resource group name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Resource Group", func(set *pflag.FlagSet) {
+		flags.addTo(set)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doUpdate(vc *cmdutils.VerbCmd, flags *rateFlags) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	rg, err := flags.resourceGroup(vc.Command.Flags())
+	if err != nil {
+		return err
+	}
+	if rg.isEmpty() {
+		return errors.New("at least one rate limit to update should be specified")
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	// the broker creates the resource group if it does not exist
+	if _, err = getResourceGroup(rc, vc.NameArg); err != nil {
+		return err
+	}
+
+	err = rc.Put(resourceGroupEndpoint(rc, vc.NameArg), rg)
+	if err == nil {
+		vc.Command.Printf("Updated resource group %s successfully\n", vc.NameArg)
+	}
+	return err
+}
@@ -0,0 +1,327 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/latency"
+)
+
+const (
+	probeIDProperty = "pulsarctl-probe-id"
+)
+
+type probeOptions struct {
+	topic              string
+	duration           time.Duration
+	interval           time.Duration
+	timeout            time.Duration
+	percentile         float64
+	maxPublishLatency  time.Duration
+	maxEndToEndLatency time.Duration
+	maxLossPercent     float64
+	serviceURL         string
+}
+
+// probeMessage is the payload of a probe message
+type probeMessage struct {
+	Seq    int   `json:"seq"`
+	SentAt int64 `json:"sentAt"`
+}
+
+// ProbeResult is the result of a probe, the latencies are in milliseconds
+type ProbeResult struct {
+	Topic           string          `json:"topic"`
+	Sent            int             `json:"sent"`
+	Received        int             `json:"received"`
+	Lost            int             `json:"lost"`
+	LossPercent     float64         `json:"lossPercent"`
+	PublishLatency  latency.Summary `json:"publishLatencyMs"`
+	EndToEndLatency latency.Summary `json:"endToEndLatencyMs"`
+	Passed          bool            `json:"passed"`
+	Failures        []string        `json:"failures,omitempty"`
+}
+
+func probeCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for probing the data path of a cluster. It publishes a timestamped " +
+		"message to a topic on every interval during the duration, consumes them back with a non-durable " +
+		"subscription, and reports the publish latency, the end to end latency and the messages lost. " +
+		"The command fails if a latency percentile or the loss exceeds its threshold, so it can run " +
+		"as a synthetic monitor."
+	desc.CommandPermission = "This command requires namespace produce and consume permissions."
+
+	var examples []cmdutils.Example
+	probe := cmdutils.Example{
+		Desc:    "Probe the data path with a topic (topic-name) for 10 seconds",
+		Command: "pulsarctl status probe --topic (topic-name)",
+	}
+	probeThresholds := cmdutils.Example{
+		Desc: "Probe the data path for one minute and fail if the p99 end to end latency exceeds 100ms " +
+			"or any message is lost",
+		Command: "pulsarctl status probe --topic (topic-name) --duration 1m --max-e2e-latency 100ms --max-loss 0",
+	}
+	examples = append(examples, probe, probeThresholds)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Topic: persistent://public/default/probe\n" +
+			"Sent: 10 - Received: 10 - Lost: 0 (0.00%)\n" +
+			"Publish latency ms: 50% 2.10 - 95% 3.50 - 99% 3.50 - max 3.50\n" +
+			"End to end latency ms: 50% 3.20 - 95% 4.80 - 99% 4.80 - max 4.80\n" +
+			"Result: PASSED",
+	}
+	failedOut := cmdutils.Output{
+		Desc: "a threshold is exceeded",
+		Out:  "[✖]  probe failed: the p99 end to end latency 120.00ms exceeds 100ms",
+	}
+	noTopicError := cmdutils.Output{
+		Desc: "the topic is not specified",
+		Out:  "[✖]  the topic is not specified",
+	}
+	out = append(out, successOut, failedOut, noTopicError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"probe",
+		"Probe the latency and the loss of the data path",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"probe")
+
+	opts := probeOptions{}
+
+	vc.SetRunFunc(func() error {
+		return doProbe(vc, &opts)
+	})
+
+	vc.FlagSetGroup.InFlagSet("Probe", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.topic, "topic", "", "The topic to publish the probe messages to")
+		set.DurationVarP(&opts.duration, "duration", "d", 10*time.Second, "The time window of the probe")
+		set.DurationVarP(&opts.interval, "interval", "i", time.Second, "The interval between two probe messages")
+		set.DurationVar(&opts.timeout, "timeout", 5*time.Second,
+			"The time to wait for the messages after the last one is published, a message received later is lost")
+		set.Float64Var(&opts.percentile, "percentile", 99, "The latency percentile compared with the thresholds")
+		set.DurationVar(&opts.maxPublishLatency, "max-publish-latency", 0,
+			"Fail if the publish latency percentile exceeds the duration, 0 disables the check")
+		set.DurationVar(&opts.maxEndToEndLatency, "max-e2e-latency", 0,
+			"Fail if the end to end latency percentile exceeds the duration, 0 disables the check")
+		set.Float64Var(&opts.maxLossPercent, "max-loss", -1,
+			"Fail if the percentage of lost messages exceeds the value, a negative value disables the check")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doProbe(vc *cmdutils.VerbCmd, opts *probeOptions) error {
+	if opts.topic == "" {
+		return errors.New("the topic is not specified")
+	}
+	if opts.interval <= 0 || opts.duration < opts.interval {
+		return errors.New("the interval must be greater than 0 and not greater than the duration")
+	}
+	if opts.percentile <= 0 || opts.percentile > 100 {
+		return errors.New("the percentile must be within (0, 100]")
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	probeID := fmt.Sprintf("%d", time.Now().UnixNano())
+
+	// the subscription is created before publishing, so no message is missed
+	consumer, err := client.Subscribe(pulsar.ConsumerOptions{
+		Topic:            opts.topic,
+		SubscriptionName: "pulsarctl-probe-" + probeID,
+		SubscriptionMode: pulsar.NonDurable,
+		Type:             pulsar.Exclusive,
+	})
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{
+		Topic:           opts.topic,
+		DisableBatching: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	publish := latency.NewHistogram()
+	endToEnd := latency.NewHistogram()
+
+	var mu sync.Mutex
+	received := make(map[int]bool)
+	recvCtx, stopReceiving := context.WithCancel(context.Background())
+	defer stopReceiving()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			msg, err := consumer.Receive(recvCtx)
+			if err != nil {
+				return
+			}
+			now := time.Now()
+
+			var pm probeMessage
+			if msg.Properties()[probeIDProperty] != probeID || json.Unmarshal(msg.Payload(), &pm) != nil {
+				continue
+			}
+			mu.Lock()
+			if !received[pm.Seq] {
+				received[pm.Seq] = true
+				latency.Record(endToEnd, now.Sub(time.Unix(0, pm.SentAt)))
+			}
+			mu.Unlock()
+		}
+	}()
+
+	sent := 0
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+	deadline := time.Now().Add(opts.duration)
+	for seq := 0; time.Now().Before(deadline); seq++ {
+		start := time.Now()
+		payload, err := json.Marshal(probeMessage{Seq: seq, SentAt: start.UnixNano()})
+		if err != nil {
+			return err
+		}
+		_, err = producer.Send(context.Background(), &pulsar.ProducerMessage{
+			Payload:    payload,
+			Properties: map[string]string{probeIDProperty: probeID},
+		})
+		if err != nil {
+			vc.Command.PrintErrf("Failed to publish the probe message #%d: %v\n", seq, err)
+		} else {
+			latency.Record(publish, time.Since(start))
+		}
+		sent++
+		<-ticker.C
+	}
+
+	// wait for the messages in flight
+	wait := time.NewTimer(opts.timeout)
+	defer wait.Stop()
+	poll := time.NewTicker(10 * time.Millisecond)
+	defer poll.Stop()
+waiting:
+	for {
+		select {
+		case <-wait.C:
+			break waiting
+		case <-poll.C:
+			mu.Lock()
+			n := len(received)
+			mu.Unlock()
+			if n >= sent {
+				break waiting
+			}
+		}
+	}
+	stopReceiving()
+	<-done
+
+	r := &ProbeResult{
+		Topic:           opts.topic,
+		Sent:            sent,
+		Received:        len(received),
+		Lost:            sent - len(received),
+		PublishLatency:  latency.Summarize(publish),
+		EndToEndLatency: latency.Summarize(endToEnd),
+	}
+	if sent > 0 {
+		r.LossPercent = float64(r.Lost) * 100 / float64(sent)
+	}
+	r.Failures = checkThresholds(opts, r.LossPercent, publish, endToEnd)
+	r.Passed = len(r.Failures) == 0
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(r).
+		WithTextFunc(func(w io.Writer) error {
+			return writeProbeText(w, r)
+		})
+	if err := vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc); err != nil {
+		return err
+	}
+
+	if !r.Passed {
+		return errors.New("probe failed: " + strings.Join(r.Failures, ", "))
+	}
+	return nil
+}
+
+// checkThresholds returns the thresholds exceeded by the probe
+func checkThresholds(opts *probeOptions, lossPercent float64, publish, endToEnd *hdrhistogram.Histogram) []string {
+	var failures []string
+	check := func(name string, h *hdrhistogram.Histogram, max time.Duration) {
+		if max <= 0 || h.TotalCount() == 0 {
+			return
+		}
+		if v := latency.Percentile(h, opts.percentile); v > float64(max.Microseconds())/1000 {
+			failures = append(failures, fmt.Sprintf("the p%g %s latency %.2fms exceeds %v",
+				opts.percentile, name, v, max))
+		}
+	}
+	check("publish", publish, opts.maxPublishLatency)
+	check("end to end", endToEnd, opts.maxEndToEndLatency)
+
+	if opts.maxLossPercent >= 0 && lossPercent > opts.maxLossPercent {
+		failures = append(failures, fmt.Sprintf("the loss %.2f%% exceeds %g%%", lossPercent, opts.maxLossPercent))
+	}
+	return failures
+}
+
+func writeProbeText(w io.Writer, r *ProbeResult) error {
+	result := "PASSED"
+	if !r.Passed {
+		result = "FAILED (" + strings.Join(r.Failures, ", ") + ")"
+	}
+	_, err := fmt.Fprintf(w,
+		"Topic: %s\n"+
+			"Sent: %d - Received: %d - Lost: %d (%.2f%%)\n"+
+			"Publish latency ms: 50%% %.2f - 95%% %.2f - 99%% %.2f - max %.2f\n"+
+			"End to end latency ms: 50%% %.2f - 95%% %.2f - 99%% %.2f - max %.2f\n"+
+			"Result: %s\n",
+		r.Topic, r.Sent, r.Received, r.Lost, r.LossPercent,
+		r.PublishLatency.P50, r.PublishLatency.P95, r.PublishLatency.P99, r.PublishLatency.Max,
+		r.EndToEndLatency.P50, r.EndToEndLatency.P95, r.EndToEndLatency.P99, r.EndToEndLatency.Max,
+		result)
+	return err
+}
@@ -0,0 +1,60 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/pulsar/latency"
+)
+
+func TestProbeNoTopic(t *testing.T) {
+	_, err, _ := testStatusCommands(probeCmd, []string{"probe"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "the topic is not specified", err.Error())
+}
+
+func TestProbeInvalidInterval(t *testing.T) {
+	args := []string{"probe", "--topic", "probe", "--duration", "1s", "--interval", "2s"}
+	_, err, _ := testStatusCommands(probeCmd, args)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the interval must be greater than 0 and not greater than the duration", err.Error())
+}
+
+func TestCheckThresholds(t *testing.T) {
+	publish := latency.NewHistogram()
+	endToEnd := latency.NewHistogram()
+	for i := 1; i <= 100; i++ {
+		latency.Record(publish, time.Duration(i)*time.Millisecond)
+		latency.Record(endToEnd, time.Duration(2*i)*time.Millisecond)
+	}
+
+	opts := &probeOptions{percentile: 99, maxLossPercent: -1}
+	assert.Empty(t, checkThresholds(opts, 10, publish, endToEnd))
+
+	opts.maxPublishLatency = 100 * time.Millisecond
+	opts.maxEndToEndLatency = 100 * time.Millisecond
+	opts.maxLossPercent = 0
+	failures := checkThresholds(opts, 10, publish, endToEnd)
+	assert.Len(t, failures, 2)
+	assert.Contains(t, failures[0], "the p99 end to end latency 198.")
+	assert.Equal(t, "the loss 10.00% exceeds 0%", failures[1])
+}
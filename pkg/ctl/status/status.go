@@ -29,6 +29,7 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 		"",
 		"status")
 	cmdutils.AddVerbCmd(flagGrouping, statusCmd, checkStatusCmd)
+	cmdutils.AddVerbCmd(flagGrouping, statusCmd, probeCmd)
 
 	return statusCmd
 }
@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// AnalyzeBacklogResult is the result of analyzing the backlog of a subscription, the filter
+// counts are the entries and messages accepted, rejected or rescheduled by the entry filters
+type AnalyzeBacklogResult struct {
+	Entries                   int64  `json:"entries"`
+	Messages                  int64  `json:"messages"`
+	FilterAcceptedEntries     int64  `json:"filterAcceptedEntries"`
+	FilterRejectedEntries     int64  `json:"filterRejectedEntries"`
+	FilterRescheduledEntries  int64  `json:"filterRescheduledEntries"`
+	FilterAcceptedMessages    int64  `json:"filterAcceptedMessages"`
+	FilterRejectedMessages    int64  `json:"filterRejectedMessages"`
+	FilterRescheduledMessages int64  `json:"filterRescheduledMessages"`
+	Aborted                   bool   `json:"aborted"`
+	FirstMessageID            string `json:"firstMessageId,omitempty"`
+	LastMessageID             string `json:"lastMessageId,omitempty"`
+}
+
+func AnalyzeBacklogCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for analyzing the backlog of a subscription, which reads " +
+		"the backlog from the mark-delete position or the given position and counts the entries and messages, " +
+		"including how many of them are accepted, rejected or rescheduled by the entry filters of the broker. " +
+		"The analysis may be aborted by the broker when it reads too many entries."
+	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
+
+	var examples []cmdutils.Example
+	analyze := cmdutils.Example{
+		Desc:    "Analyze the backlog of the subscription (subscription-name) of the topic (topic-name)",
+		Command: "pulsarctl subscriptions analyze-backlog (topic-name) (subscription-name)",
+	}
+
+	analyzeFrom := cmdutils.Example{
+		Desc:    "Analyze the backlog of the subscription (subscription-name) starting from the position (position)",
+		Command: "pulsarctl subscriptions analyze-backlog --position (ledgerId:entryId) (topic-name) (subscription-name)",
+	}
+	examples = append(examples, analyze, analyzeFrom)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-------------+---------+----------+\n" +
+			"|             | ENTRIES | MESSAGES |\n" +
+			"+-------------+---------+----------+\n" +
+			"| Total       | 120     | 1200     |\n" +
+			"| Accepted    | 100     | 1000     |\n" +
+			"| Rejected    | 20      | 200      |\n" +
+			"| Rescheduled | 0       | 0        |\n" +
+			"+-------------+---------+----------+\n" +
+			"First message id: 12:0\n" +
+			"Last message id: 12:119",
+	}
+	out = append(out, successOut, ArgsError, TopicNotFoundError, SubNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	out = append(out, MessageIDErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"analyze-backlog",
+		"Analyze the backlog of a subscription",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"analyze-backlog")
+
+	var position string
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doAnalyzeBacklog(vc, position)
+	}, CheckSubscriptionNameTwoArgs)
+
+	vc.FlagSetGroup.InFlagSet("AnalyzeBacklog", func(set *pflag.FlagSet) {
+		set.StringVarP(&position, "position", "p", "",
+			"message id to start analyzing from (e.g. ledgerId:entryId), defaults to the mark-delete position")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doAnalyzeBacklog(vc *cmdutils.VerbCmd, position string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArgs[0])
+	if err != nil {
+		return err
+	}
+	sName := vc.NameArgs[1]
+
+	var start *resetCursorData
+	if position != "" {
+		id, err := utils.ParseMessageID(position)
+		if err != nil {
+			return errors.Errorf("invalid position value : %s", position)
+		}
+		data := newResetCursorData(*id)
+		start = &data
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	var result AnalyzeBacklogResult
+	err = rc.PostWithObj(rc.Endpoint("", topic.GetRestPath(), "subscription", sName, "analyzeBacklog"),
+		start, &result)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(result).
+		WithTextFunc(func(w io.Writer) error {
+			return writeAnalyzeBacklogResult(w, &result)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func writeAnalyzeBacklogResult(w io.Writer, r *AnalyzeBacklogResult) error {
+	table := tablewriter.NewWriter(w)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeader([]string{"", "Entries", "Messages"})
+	counts := []struct {
+		name     string
+		entries  int64
+		messages int64
+	}{
+		{"Total", r.Entries, r.Messages},
+		{"Accepted", r.FilterAcceptedEntries, r.FilterAcceptedMessages},
+		{"Rejected", r.FilterRejectedEntries, r.FilterRejectedMessages},
+		{"Rescheduled", r.FilterRescheduledEntries, r.FilterRescheduledMessages},
+	}
+	for _, c := range counts {
+		table.Append([]string{c.name, strconv.FormatInt(c.entries, 10), strconv.FormatInt(c.messages, 10)})
+	}
+	table.Render()
+
+	if r.FirstMessageID != "" {
+		if _, err := fmt.Fprintf(w, "First message id: %s\n", r.FirstMessageID); err != nil {
+			return err
+		}
+	}
+	if r.LastMessageID != "" {
+		if _, err := fmt.Fprintf(w, "Last message id: %s\n", r.LastMessageID); err != nil {
+			return err
+		}
+	}
+	if r.Aborted {
+		_, err := fmt.Fprintln(w, "The analysis was aborted before reaching the end of the backlog")
+		return err
+	}
+	return nil
+}
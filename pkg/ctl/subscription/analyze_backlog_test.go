@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeBacklogCmd(t *testing.T) {
+	args := []string{"create", "test-analyze-backlog-topic", "test-analyze-backlog-sub"}
+	_, execErr, _, _ := TestSubCommands(CreateCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"analyze-backlog", "test-analyze-backlog-topic", "test-analyze-backlog-sub"}
+	out, execErr, _, _ := TestSubCommands(AnalyzeBacklogCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "| Total       | 0       | 0        |")
+}
+
+func TestAnalyzeBacklogArgsError(t *testing.T) {
+	args := []string{"analyze-backlog", "test-analyze-backlog-topic"}
+	_, _, nameErr, _ := TestSubCommands(AnalyzeBacklogCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "need to specified the topic name and the subscription name", nameErr.Error())
+
+	args = []string{"analyze-backlog", "--position", "invalid", "test-analyze-backlog-topic",
+		"test-analyze-backlog-sub"}
+	_, execErr, _, _ := TestSubCommands(AnalyzeBacklogCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid position value : invalid", execErr.Error())
+}
+
+func TestWriteAnalyzeBacklogResult(t *testing.T) {
+	result := AnalyzeBacklogResult{
+		Entries:                120,
+		Messages:               1200,
+		FilterAcceptedEntries:  100,
+		FilterRejectedEntries:  20,
+		FilterAcceptedMessages: 1000,
+		FilterRejectedMessages: 200,
+		FirstMessageID:         "12:0",
+		LastMessageID:          "12:119",
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, writeAnalyzeBacklogResult(&buf, &result))
+	assert.Equal(t, "+-------------+---------+----------+\n"+
+		"|             | ENTRIES | MESSAGES |\n"+
+		"+-------------+---------+----------+\n"+
+		"| Total       | 120     | 1200     |\n"+
+		"| Accepted    | 100     | 1000     |\n"+
+		"| Rejected    | 20      | 200      |\n"+
+		"| Rescheduled | 0       | 0        |\n"+
+		"+-------------+---------+----------+\n"+
+		"First message id: 12:0\n"+
+		"Last message id: 12:119\n", buf.String())
+
+	result.Aborted = true
+	buf.Reset()
+	assert.Nil(t, writeAnalyzeBacklogResult(&buf, &result))
+	assert.Contains(t, buf.String(), "The analysis was aborted before reaching the end of the backlog\n")
+}
@@ -0,0 +1,360 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin/config"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// CloneMapping is the position of a cloned subscription on a destination partition and how
+// close its backlog is to the backlog of the source subscription
+type CloneMapping struct {
+	SourceTopic        string `json:"sourceTopic"`
+	DestinationTopic   string `json:"destinationTopic"`
+	MarkDeletePosition string `json:"markDeletePosition"`
+	PublishTime        string `json:"publishTime,omitempty"`
+	SourceBacklog      int64  `json:"sourceBacklog"`
+	DestinationBacklog int64  `json:"destinationBacklog"`
+	BacklogDifference  int64  `json:"backlogDifference"`
+	Error              string `json:"error,omitempty"`
+}
+
+func CloneCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for cloning a subscription to another topic, which may be " +
+		"in another cluster. The subscription is created on the destination topic at the first message " +
+		"published after the message at the mark-delete position of the source subscription. Each partition " +
+		"of a partitioned topic is cloned to the partition with the same index of the destination topic, " +
+		"and the backlog of both subscriptions is reported for each partition to show how accurate the " +
+		"mapping is."
+	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
+
+	var examples []cmdutils.Example
+	clone := cmdutils.Example{
+		Desc: "Clone the subscription (subscription-name) of the topic (source-topic) to the topic " +
+			"(destination-topic)",
+		Command: "pulsarctl subscriptions clone (source-topic) (subscription-name) (destination-topic)",
+	}
+
+	cloneCrossContext := cmdutils.Example{
+		Desc: "Clone the subscription (subscription-name) to the topic (destination-topic) in the cluster " +
+			"of the context (dr-context)",
+		Command: "pulsarctl subscriptions clone --destination-context (dr-context) " +
+			"(source-topic) (subscription-name) (destination-topic)",
+	}
+
+	cloneRename := cmdutils.Example{
+		Desc: "Clone the subscription (subscription-name) as the subscription (new-subscription-name)",
+		Command: "pulsarctl subscriptions clone --destination-subscription (new-subscription-name) " +
+			"(source-topic) (subscription-name) (destination-topic)",
+	}
+	examples = append(examples, clone, cloneCrossContext, cloneRename)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "(the mapping of each partition)\n" +
+			"Clone the subscription (subscription-name) of the topic (source-topic) to the topic " +
+			"(destination-topic) successfully",
+	}
+
+	argsError := cmdutils.Output{
+		Desc: "the source topic, the subscription or the destination topic is not specified",
+		Out:  "[✖]  need to specified the source topic name, the subscription name and the destination topic name",
+	}
+
+	partitionsError := cmdutils.Output{
+		Desc: "the source topic and the destination topic have different number of partitions",
+		Out: "[✖]  the source topic has (n) partitions but the destination topic has (m) partitions, " +
+			"they should have the same number of partitions",
+	}
+
+	contextError := cmdutils.Output{
+		Desc: "the specified context does not exist",
+		Out:  "[✖]  the context (context-name) does not exist",
+	}
+
+	out = append(out, successOut, argsError, partitionsError, contextError, TopicNotFoundError, SubNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"clone",
+		"Clone a subscription to another topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"clone")
+
+	var sourceContext string
+	var destinationContext string
+	var destinationSub string
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doClone(vc, sourceContext, destinationContext, destinationSub)
+	}, func(args []string) error {
+		if len(args) != 3 {
+			return errors.New("need to specified the source topic name, the subscription name " +
+				"and the destination topic name")
+		}
+		return nil
+	})
+
+	vc.FlagSetGroup.InFlagSet("Clone", func(set *pflag.FlagSet) {
+		set.StringVar(&sourceContext, "source-context", "",
+			"the context of the cluster of the source topic, defaults to the current cluster")
+		set.StringVar(&destinationContext, "destination-context", "",
+			"the context of the cluster of the destination topic, defaults to the cluster of the source topic")
+		set.StringVar(&destinationSub, "destination-subscription", "",
+			"the name of the subscription on the destination topic, defaults to the source subscription name")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doClone(vc *cmdutils.VerbCmd, sourceContext, destinationContext, destinationSub string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	source, err := utils.GetTopicName(vc.NameArgs[0])
+	if err != nil {
+		return err
+	}
+	sub := vc.NameArgs[1]
+	destination, err := utils.GetTopicName(vc.NameArgs[2])
+	if err != nil {
+		return err
+	}
+	if destinationSub == "" {
+		destinationSub = sub
+	}
+
+	sourceClient, err := contextClient(sourceContext)
+	if err != nil {
+		return err
+	}
+	destinationClient := sourceClient
+	if destinationContext != "" {
+		if destinationClient, err = contextClient(destinationContext); err != nil {
+			return err
+		}
+	}
+
+	sourcePartitions, err := cmdutils.TopicPartitions(sourceClient.Topics(), *source)
+	if err != nil {
+		return err
+	}
+	destinationPartitions, err := cmdutils.TopicPartitions(destinationClient.Topics(), *destination)
+	if err != nil {
+		return err
+	}
+	if len(sourcePartitions) != len(destinationPartitions) {
+		return errors.Errorf("the source topic has %d partitions but the destination topic has %d partitions, "+
+			"they should have the same number of partitions", len(sourcePartitions), len(destinationPartitions))
+	}
+
+	mappings := make([]CloneMapping, len(sourcePartitions))
+	var wg sync.WaitGroup
+	for i := range sourcePartitions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mappings[i] = clonePartition(sourceClient, sourcePartitions[i], sub,
+				destinationClient, destinationPartitions[i], destinationSub)
+		}(i)
+	}
+	wg.Wait()
+
+	return writeCloneMappings(vc, mappings, fmt.Sprintf(
+		"Clone the subscription %s of the topic %s to the topic %s successfully",
+		sub, source.String(), destination.String()))
+}
+
+// contextClient creates the admin client of the cluster of the context, or of the current
+// cluster if the context is not specified
+func contextClient(name string) (admin.Client, error) {
+	if name == "" {
+		return cmdutils.NewPulsarClient(), nil
+	}
+	cfg, err := cmdutils.LoadContext(name)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Client(config.V2), nil
+}
+
+// clonePartition creates the subscription on the destination partition at the publish time of
+// the message at the mark-delete position of the source subscription
+func clonePartition(sourceClient admin.Client, source utils.TopicName, sub string,
+	destinationClient admin.Client, destination utils.TopicName, destinationSub string) CloneMapping {
+	m := CloneMapping{SourceTopic: source.String(), DestinationTopic: destination.String()}
+	failed := func(err error) CloneMapping {
+		m.Error = err.Error()
+		return m
+	}
+
+	internal, err := sourceClient.Topics().GetInternalStats(source)
+	if err != nil {
+		return failed(err)
+	}
+	cursor, ok := internal.Cursors[sub]
+	if !ok {
+		return failed(errors.Errorf("the subscription %s does not exist on the topic %s", sub, source.String()))
+	}
+	m.MarkDeletePosition = cursor.MarkDeletePosition
+
+	stats, err := sourceClient.Topics().GetStats(source)
+	if err != nil {
+		return failed(err)
+	}
+	m.SourceBacklog = stats.Subscriptions[sub].MsgBacklog
+
+	if err = destinationClient.Subscriptions().Create(destination, destinationSub, utils.Latest); err != nil {
+		return failed(err)
+	}
+
+	// the subscription stays at the latest position if the source subscription has no backlog
+	if m.SourceBacklog > 0 {
+		publishTime, err := markDeletePublishTime(sourceClient, source, sub, cursor.MarkDeletePosition)
+		if err != nil {
+			return failed(err)
+		}
+		m.PublishTime = publishTime.Format(time.RFC3339Nano)
+		err = destinationClient.Subscriptions().ResetCursorToTimestamp(destination, destinationSub,
+			publishTime.UnixMilli())
+		if err != nil {
+			return failed(err)
+		}
+	}
+
+	stats, err = destinationClient.Topics().GetStats(destination)
+	if err != nil {
+		return failed(err)
+	}
+	m.DestinationBacklog = stats.Subscriptions[destinationSub].MsgBacklog
+	m.BacklogDifference = m.DestinationBacklog - m.SourceBacklog
+	return m
+}
+
+// markDeletePublishTime returns the publish time of the message at the mark-delete position. If
+// that message can not be read, such as the mark-delete position is at the start of a ledger, it
+// returns the time right before the first unacknowledged message of the subscription
+func markDeletePublishTime(client admin.Client, topic utils.TopicName, sub, position string) (time.Time, error) {
+	if id, err := utils.ParseMessageID(position); err == nil && id.EntryID >= 0 {
+		msg, err := client.Subscriptions().GetMessageByID(topic, id.LedgerID, id.EntryID)
+		if err == nil {
+			if t, err := messagePublishTime(msg); err == nil {
+				return t, nil
+			}
+		}
+	}
+
+	msgs, err := client.Subscriptions().PeekMessages(topic, sub, 1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(msgs) == 0 {
+		return time.Time{}, errors.Errorf("no message to clone the position of the subscription %s from", sub)
+	}
+	t, err := messagePublishTime(msgs[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Add(-time.Millisecond), nil
+}
+
+func messagePublishTime(msg *utils.Message) (time.Time, error) {
+	value, ok := msg.Properties["publish-time"]
+	if !ok {
+		return time.Time{}, errors.New("the publish time of the message is unknown")
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+func writeCloneMappings(vc *cmdutils.VerbCmd, mappings []CloneMapping, message string) error {
+	failed := 0
+	var firstErr string
+	for _, m := range mappings {
+		if m.Error != "" {
+			if failed == 0 {
+				firstErr = m.Error
+			}
+			failed++
+		}
+	}
+	if failed == len(mappings) {
+		return errors.New(firstErr)
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(mappings).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetAlignment(tablewriter.ALIGN_LEFT)
+			header := []string{"Source", "Destination", "Mark Delete Position", "Publish Time",
+				"Source Backlog", "Destination Backlog", "Difference"}
+			if failed > 0 {
+				header = append(header, "Error")
+			}
+			table.SetHeader(header)
+			for _, m := range mappings {
+				publishTime := m.PublishTime
+				if publishTime == "" {
+					publishTime = "-"
+				}
+				row := []string{m.SourceTopic, m.DestinationTopic, m.MarkDeletePosition, publishTime,
+					strconv.FormatInt(m.SourceBacklog, 10), strconv.FormatInt(m.DestinationBacklog, 10),
+					formatDelta(m.BacklogDifference)}
+				if m.Error != "" {
+					row = []string{m.SourceTopic, m.DestinationTopic, "-", "-", "-", "-", "-"}
+				}
+				if failed > 0 {
+					row = append(row, m.Error)
+				}
+				table.Append(row)
+			}
+			table.Render()
+			if failed == 0 {
+				_, err := fmt.Fprintln(w, message)
+				return err
+			}
+			return nil
+		})
+	if err := vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errors.Errorf("failed on %d of %d partitions: %s", failed, len(mappings), firstErr)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneCmd(t *testing.T) {
+	args := []string{"create", "test-clone-source-topic", "test-clone-sub"}
+	_, execErr, _, _ := TestSubCommands(CreateCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"clone", "test-clone-source-topic", "test-clone-sub", "test-clone-destination-topic"}
+	out, execErr, _, _ := TestSubCommands(CloneCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "Clone the subscription test-clone-sub of the topic "+
+		"persistent://public/default/test-clone-source-topic to the topic "+
+		"persistent://public/default/test-clone-destination-topic successfully\n")
+
+	args = []string{"list", "test-clone-destination-topic"}
+	out, execErr, _, _ = TestSubCommands(ListCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "test-clone-sub")
+}
+
+func TestCloneArgsError(t *testing.T) {
+	args := []string{"clone", "test-clone-source-topic", "test-clone-sub"}
+	_, _, nameErr, _ := TestSubCommands(CloneCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "need to specified the source topic name, the subscription name "+
+		"and the destination topic name", nameErr.Error())
+}
+
+func TestCloneNonExistingSub(t *testing.T) {
+	args := []string{"create", "test-clone-non-existing-sub-topic", "test-clone-existing-sub"}
+	_, execErr, _, _ := TestSubCommands(CreateCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"clone", "test-clone-non-existing-sub-topic", "test-clone-non-existing-sub",
+		"test-clone-non-existing-sub-destination-topic"}
+	_, execErr, _, _ = TestSubCommands(CloneCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the subscription test-clone-non-existing-sub does not exist on the topic "+
+		"persistent://public/default/test-clone-non-existing-sub-topic", execErr.Error())
+}
+
+func TestMessagePublishTime(t *testing.T) {
+	msg := utils.NewMessage("test-topic", utils.MessageID{LedgerID: 1, EntryID: 2},
+		nil, map[string]string{"publish-time": "2024-01-02T15:04:05.123+08:00"})
+	publishTime, err := messagePublishTime(msg)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 7, 4, 5, 123e6, time.UTC), publishTime.UTC())
+
+	msg = utils.NewMessage("test-topic", utils.MessageID{LedgerID: 1, EntryID: 2}, nil, nil)
+	_, err = messagePublishTime(msg)
+	assert.NotNil(t, err)
+}
@@ -43,7 +43,13 @@ func CreateCmd(vc *cmdutils.VerbCmd) {
 			"position (position)",
 		Command: "pulsarctl subscription create --messageId (position) (topic-name) (subscription-name)",
 	}
-	examples = append(examples, create, createWithFlag)
+	createWithProperties := cmdutils.Example{
+		Desc: "Create a subscription (subscription-name) on a topic (topic-name) with the properties " +
+			"(key)=(value)",
+		Command: "pulsarctl subscription create --property team=payments --property env=prod " +
+			"(topic-name) (subscription-name)",
+	}
+	examples = append(examples, create, createWithFlag, createWithProperties)
 	desc.CommandExamples = examples
 
 	var out []cmdutils.Output
@@ -58,6 +64,7 @@ func CreateCmd(vc *cmdutils.VerbCmd) {
 	desc.CommandOutput = out
 
 	var ID string
+	var properties map[string]string
 
 	vc.SetDescription(
 		"create",
@@ -66,18 +73,20 @@ func CreateCmd(vc *cmdutils.VerbCmd) {
 		desc.ExampleToString())
 
 	vc.SetRunFuncWithMultiNameArgs(func() error {
-		return doCreate(vc, ID)
+		return doCreate(vc, ID, properties)
 	}, CheckSubscriptionNameTwoArgs)
 
 	vc.FlagSetGroup.InFlagSet("Create Subscription", func(set *pflag.FlagSet) {
 		set.StringVarP(&ID, "messageId", "m", "latest",
 			"message id where the subscription starts from. It can be either 'latest', "+
 				"'earliest' or (ledgerId:entryId)")
+		set.StringToStringVarP(&properties, "property", "p", nil,
+			"Properties of the subscription (key=value), can be specified multiple times")
 	})
 	vc.EnableOutputFlagSet()
 }
 
-func doCreate(vc *cmdutils.VerbCmd, id string) error {
+func doCreate(vc *cmdutils.VerbCmd, id string, properties map[string]string) error {
 	// for testing
 	if vc.NameError != nil {
 		return vc.NameError
@@ -108,8 +117,16 @@ func doCreate(vc *cmdutils.VerbCmd, id string) error {
 		messageID = *i
 	}
 
-	admin := cmdutils.NewPulsarClient()
-	err = admin.Subscriptions().Create(*topic, sName, messageID)
+	if len(properties) > 0 {
+		// the admin client does not support creating a subscription with properties
+		rc := cmdutils.NewPulsarRestClient()
+		data := newResetCursorData(messageID)
+		data.Properties = properties
+		err = rc.Put(rc.Endpoint("", topic.GetRestPath(), "subscription", sName), data)
+	} else {
+		admin := cmdutils.NewPulsarClient()
+		err = admin.Subscriptions().Create(*topic, sName, messageID)
+	}
 	if err == nil {
 		vc.Command.Printf("Create subscription %s on topic %s starting from %s successfully\n",
 			sName, topic.String(), id)
@@ -0,0 +1,203 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// CursorChange is the position and the backlog of a subscription on a partition
+// after its cursor was moved
+type CursorChange struct {
+	Topic              string `json:"topic"`
+	Subscription       string `json:"subscription"`
+	MarkDeletePosition string `json:"markDeletePosition"`
+	ReadPosition       string `json:"readPosition"`
+	BacklogBefore      int64  `json:"backlogBefore"`
+	BacklogAfter       int64  `json:"backlogAfter"`
+	BacklogDelta       int64  `json:"backlogDelta"`
+	Error              string `json:"error,omitempty"`
+}
+
+// resetCursorData is the position in the request body of the reset cursor, the create subscription
+// and the analyze backlog endpoints, it also allows to reset the cursor right after a position
+type resetCursorData struct {
+	LedgerID       int64             `json:"ledgerId"`
+	EntryID        int64             `json:"entryId"`
+	PartitionIndex int               `json:"partitionIndex"`
+	BatchIndex     int               `json:"batchIndex"`
+	IsExcluded     bool              `json:"isExcluded"`
+	Properties     map[string]string `json:"properties,omitempty"`
+}
+
+func newResetCursorData(id utils.MessageID) resetCursorData {
+	return resetCursorData{
+		LedgerID:       id.LedgerID,
+		EntryID:        id.EntryID,
+		PartitionIndex: id.PartitionIndex,
+		BatchIndex:     id.BatchIndex,
+	}
+}
+
+// moveCursors runs move on the partitions concurrently and reports the position and the
+// backlog change of the subscription on each partition, or of all the subscriptions when
+// sub is empty
+func moveCursors(topics admin.Topics, partitions []utils.TopicName, sub string,
+	move func(partition utils.TopicName) error) []CursorChange {
+	results := make([][]CursorChange, len(partitions))
+	var wg sync.WaitGroup
+	for i, p := range partitions {
+		wg.Add(1)
+		go func(i int, p utils.TopicName) {
+			defer wg.Done()
+			results[i] = moveCursor(topics, p, sub, move)
+		}(i, p)
+	}
+	wg.Wait()
+
+	var changes []CursorChange
+	for _, r := range results {
+		changes = append(changes, r...)
+	}
+	return changes
+}
+
+func moveCursor(topics admin.Topics, partition utils.TopicName, sub string,
+	move func(partition utils.TopicName) error) []CursorChange {
+	failed := func(err error) []CursorChange {
+		return []CursorChange{{Topic: partition.String(), Subscription: sub, Error: err.Error()}}
+	}
+
+	before, err := topics.GetStats(partition)
+	if err != nil {
+		return failed(err)
+	}
+	if err = move(partition); err != nil {
+		return failed(err)
+	}
+	after, err := topics.GetStats(partition)
+	if err != nil {
+		return failed(err)
+	}
+	internal, err := topics.GetInternalStats(partition)
+	if err != nil {
+		return failed(err)
+	}
+
+	var subs []string
+	if sub != "" {
+		subs = []string{sub}
+	} else {
+		for name := range after.Subscriptions {
+			subs = append(subs, name)
+		}
+		sort.Strings(subs)
+	}
+
+	changes := make([]CursorChange, 0, len(subs))
+	for _, name := range subs {
+		c := CursorChange{
+			Topic:              partition.String(),
+			Subscription:       name,
+			MarkDeletePosition: internal.Cursors[name].MarkDeletePosition,
+			ReadPosition:       internal.Cursors[name].ReadPosition,
+			BacklogBefore:      before.Subscriptions[name].MsgBacklog,
+			BacklogAfter:       after.Subscriptions[name].MsgBacklog,
+		}
+		c.BacklogDelta = c.BacklogAfter - c.BacklogBefore
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// writeCursorChanges writes the cursor changes followed by the message, an error is
+// returned if the cursor could not be moved on some partitions
+func writeCursorChanges(vc *cmdutils.VerbCmd, changes []CursorChange, message string) error {
+	failed := 0
+	var firstErr string
+	partitions := make(map[string]bool)
+	for _, c := range changes {
+		partitions[c.Topic] = true
+		if c.Error != "" {
+			if failed == 0 {
+				firstErr = c.Error
+			}
+			failed++
+		}
+	}
+	// nothing to report if the cursor was not moved at all
+	if failed > 0 && failed == len(partitions) {
+		return errors.New(firstErr)
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(changes).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetAlignment(tablewriter.ALIGN_LEFT)
+			header := []string{"Topic", "Subscription", "Mark Delete Position", "Read Position",
+				"Backlog Before", "Backlog After", "Delta"}
+			if failed > 0 {
+				header = append(header, "Error")
+			}
+			table.SetHeader(header)
+			for _, c := range changes {
+				row := []string{c.Topic, c.Subscription, c.MarkDeletePosition, c.ReadPosition,
+					strconv.FormatInt(c.BacklogBefore, 10), strconv.FormatInt(c.BacklogAfter, 10),
+					formatDelta(c.BacklogDelta)}
+				if c.Error != "" {
+					row = []string{c.Topic, c.Subscription, "-", "-", "-", "-", "-"}
+				}
+				if failed > 0 {
+					row = append(row, c.Error)
+				}
+				table.Append(row)
+			}
+			table.Render()
+			if failed == 0 {
+				_, err := fmt.Fprintln(w, message)
+				return err
+			}
+			return nil
+		})
+	if err := vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errors.Errorf("failed on %d of %d partitions: %s", failed, len(partitions), firstErr)
+	}
+	return nil
+}
+
+func formatDelta(d int64) string {
+	if d > 0 {
+		return "+" + strconv.FormatInt(d, 10)
+	}
+	return strconv.FormatInt(d, 10)
+}
@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpireTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	seconds, err := parseExpireTime("60", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(60), seconds)
+
+	seconds, err = parseExpireTime("1h", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3600), seconds)
+
+	seconds, err = parseExpireTime("-30m", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1800), seconds)
+
+	seconds, err = parseExpireTime("2024-01-02T14:04:05Z", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3600), seconds)
+
+	_, err = parseExpireTime("2024-01-02T16:04:05Z", now)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the expire time 2024-01-02T16:04:05Z is in the future", err.Error())
+
+	_, err = parseExpireTime("invalid", now)
+	assert.NotNil(t, err)
+}
+
+func TestParseResetMessageID(t *testing.T) {
+	id, err := parseResetMessageID("earliest")
+	assert.Nil(t, err)
+	assert.Equal(t, utils.Earliest, id)
+
+	id, err = parseResetMessageID("LATEST")
+	assert.Nil(t, err)
+	assert.Equal(t, utils.Latest, id)
+
+	id, err = parseResetMessageID("10:2:1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), id.LedgerID)
+	assert.Equal(t, int64(2), id.EntryID)
+	assert.Equal(t, 1, id.PartitionIndex)
+
+	_, err = parseResetMessageID("invalid")
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid position value : invalid", err.Error())
+}
+
+func TestMessageIDPartitions(t *testing.T) {
+	topic, err := utils.GetTopicName("test-message-id-partitions")
+	assert.Nil(t, err)
+	var partitions []utils.TopicName
+	for i := 0; i < 3; i++ {
+		p, err := topic.GetPartition(i)
+		assert.Nil(t, err)
+		partitions = append(partitions, *p)
+	}
+
+	result, err := messageIDPartitions(topic, partitions, utils.Earliest)
+	assert.Nil(t, err)
+	assert.Equal(t, partitions, result)
+
+	id, err := parseResetMessageID("10:2:1")
+	assert.Nil(t, err)
+	result, err = messageIDPartitions(topic, partitions, id)
+	assert.Nil(t, err)
+	assert.Equal(t, partitions[1:2], result)
+
+	id, err = parseResetMessageID("10:2")
+	assert.Nil(t, err)
+	_, err = messageIDPartitions(topic, partitions, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the message id of a partitioned topic should be in the form of "+
+		"ledgerId:entryId:partitionIndex", err.Error())
+
+	id, err = parseResetMessageID("10:2:3")
+	assert.Nil(t, err)
+	_, err = messageIDPartitions(topic, partitions, id)
+	assert.NotNil(t, err)
+
+	result, err = messageIDPartitions(topic, []utils.TopicName{*topic}, id)
+	assert.Nil(t, err)
+	assert.Equal(t, []utils.TopicName{*topic}, result)
+}
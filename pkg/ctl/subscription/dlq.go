@@ -0,0 +1,117 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/spf13/cobra"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+const (
+	dlqSuffix   = "-DLQ"
+	retrySuffix = "-RETRY"
+
+	partitionSuffix = "-partition-"
+)
+
+func dlqCommand(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	resourceCmd := cmdutils.NewResourceCmd(
+		"dlq",
+		"Operations about dead letter topics",
+		"",
+		"dead-letter")
+
+	commands := []func(cmd *cmdutils.VerbCmd){
+		DLQListCmd,
+		DLQPeekCmd,
+		DLQReplayCmd,
+	}
+
+	cmdutils.AddVerbCmds(flagGrouping, resourceCmd, commands...)
+
+	return resourceCmd
+}
+
+// dlqTopic is a dead letter or retry letter topic along with the topic and the
+// subscription it was created for
+type dlqTopic struct {
+	Topic        string `json:"topic"`
+	Type         string `json:"type"`
+	OriginTopic  string `json:"originTopic,omitempty"`
+	Subscription string `json:"subscription,omitempty"`
+}
+
+// findDLQTopics finds the dead letter and retry letter topics among the topics of a
+// namespace. The topics are named <topic>-<subscription>-DLQ and <topic>-<subscription>-RETRY,
+// the origin topic is the longest of the other topics prefixing the name.
+func findDLQTopics(topics []string) []dlqTopic {
+	names := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		names[partitionedTopicName(t)] = true
+	}
+
+	var dlqs []dlqTopic
+	for name := range names {
+		var base, kind string
+		switch {
+		case strings.HasSuffix(name, dlqSuffix):
+			base, kind = strings.TrimSuffix(name, dlqSuffix), "DLQ"
+		case strings.HasSuffix(name, retrySuffix):
+			base, kind = strings.TrimSuffix(name, retrySuffix), "RETRY"
+		default:
+			continue
+		}
+
+		d := dlqTopic{Topic: name, Type: kind}
+		for origin := range names {
+			if origin != name && len(origin) > len(d.OriginTopic) && strings.HasPrefix(base, origin+"-") {
+				d.OriginTopic = origin
+				d.Subscription = strings.TrimPrefix(base, origin+"-")
+			}
+		}
+		dlqs = append(dlqs, d)
+	}
+	return dlqs
+}
+
+// partitionedTopicName strips the partition suffix of a partition name
+func partitionedTopicName(topic string) string {
+	if i := strings.LastIndex(topic, partitionSuffix); i > 0 {
+		return topic[:i]
+	}
+	return topic
+}
+
+// replayProperties returns the properties of a dead letter message without the
+// properties added by the client when it was sent to the dead letter topic
+func replayProperties(props map[string]string) map[string]string {
+	r := make(map[string]string, len(props))
+	for k, v := range props {
+		switch k {
+		case pulsar.SysPropertyRealTopic, pulsar.PropertyOriginMessageID, pulsar.SysPropertyOriginMessageID,
+			pulsar.SysPropertyReconsumeTimes, pulsar.SysPropertyDelayTime:
+			continue
+		}
+		r[k] = v
+	}
+	return r
+}
@@ -0,0 +1,109 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"io"
+	"sort"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func DLQListCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the dead letter topics and the retry letter topics " +
+		"of a namespace. The topics are discovered by their names, which are (topic)-(subscription)-DLQ and " +
+		"(topic)-(subscription)-RETRY by default."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	list := cmdutils.Example{
+		Desc:    "List the dead letter topics and the retry letter topics of a namespace (namespace-name)",
+		Command: "pulsarctl subscriptions dlq list (namespace-name)",
+	}
+	examples = append(examples, list)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+-------------------------------------------------+------+--------------------------------------+--------------+
+|                    DLQ TOPIC                    | TYPE |             ORIGIN TOPIC             | SUBSCRIPTION |
++-------------------------------------------------+------+--------------------------------------+--------------+
+| persistent://public/default/my-topic-my-sub-DLQ | DLQ  | persistent://public/default/my-topic | my-sub       |
++-------------------------------------------------+------+--------------------------------------+--------------+`,
+	}
+	argError := cmdutils.Output{
+		Desc: "the namespace name is not specified or the namespace name is specified more than one",
+		Out:  "[✖]  the namespace name is not specified or the namespace name is specified more than one",
+	}
+	out = append(out, successOut, argError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"list",
+		"List the dead letter topics of a namespace",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doDLQList(vc)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doDLQList(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	partitioned, nonPartitioned, err := admin.Topics().List(*ns)
+	if err != nil {
+		return err
+	}
+
+	dlqs := findDLQTopics(append(partitioned, nonPartitioned...))
+	sort.Slice(dlqs, func(i, j int) bool {
+		return dlqs[i].Topic < dlqs[j].Topic
+	})
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(dlqs).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"DLQ Topic", "Type", "Origin Topic", "Subscription"})
+			for _, d := range dlqs {
+				table.Append([]string{d.Topic, d.Type, d.OriginTopic, d.Subscription})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
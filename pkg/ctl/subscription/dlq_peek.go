@@ -0,0 +1,174 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+// dlqMessage is a message of a dead letter topic along with the topic and the id
+// of the message it was originally published as
+type dlqMessage struct {
+	*message.Message
+	RealTopic       string `json:"realTopic,omitempty"`
+	OriginMessageID string `json:"originMessageId,omitempty"`
+}
+
+func newDLQMessage(m *message.Message) *dlqMessage {
+	return &dlqMessage{
+		Message:         m,
+		RealTopic:       m.Properties[pulsar.SysPropertyRealTopic],
+		OriginMessageID: m.Properties[pulsar.PropertyOriginMessageID],
+	}
+}
+
+func DLQPeekCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for peeking the messages of a dead letter topic, along with " +
+		"the topic and the id of the message each of them was originally published as. The messages are " +
+		"read from the beginning of the topic without a subscription."
+	desc.CommandPermission = "This command requires namespace consume permissions."
+
+	var examples []cmdutils.Example
+	peek := cmdutils.Example{
+		Desc:    "Peek the first messages of a dead letter topic (dlq-topic-name)",
+		Command: "pulsarctl subscriptions dlq peek --count (n) (dlq-topic-name)",
+	}
+	peekRaw := cmdutils.Example{
+		Desc:    "Peek the first messages of a dead letter topic without decoding the payload",
+		Command: "pulsarctl subscriptions dlq peek --count (n) --raw (dlq-topic-name)",
+	}
+	examples = append(examples, peek, peekRaw)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `Real Topic       : (topic-name)
+Origin Message ID: ledgerID:entryID:PartitionIndex:BatchIndex
+Message ID       : ledgerID:entryID:PartitionIndex:BatchIndex
+Publish Time     : 2020-01-01T00:00:00Z
+Key              : (key)
+Producer         : (producer-name)
+Redelivery Count : 0
+Properties       : {}
+Message :
+(decoded payload)`,
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"peek",
+		"Peek the messages of a dead letter topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var count int
+	var raw bool
+	var serviceURL string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doDLQPeek(vc, count, raw, serviceURL)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("DLQ Peek", func(set *pflag.FlagSet) {
+		set.IntVarP(&count, "count", "n", 1, "Number of messages (default 1)")
+		set.BoolVar(&raw, "raw", false, "Print the payload as a hex dump instead of decoding it with the topic schema")
+		cmdutils.AddServiceURLFlag(set, &serviceURL)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doDLQPeek(vc *cmdutils.VerbCmd, n int, raw bool, serviceURL string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	partitions, err := cmdutils.TopicPartitions(admin.Topics(), *topic)
+	if err != nil {
+		return err
+	}
+
+	client, err := cmdutils.NewDataClient(serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var decoder *message.Decoder
+	if !raw {
+		decoder = message.NewDecoder(message.AdminSchemaFetcher(admin.Schemas(), topic.String()))
+	}
+
+	var msgs []*dlqMessage
+	for _, p := range partitions {
+		if len(msgs) >= n {
+			break
+		}
+		err = message.ReadRange(context.Background(), client, p.String(), message.Range{},
+			func(msg pulsar.Message) (bool, error) {
+				m := message.FromPulsarMessage(msg)
+				if decoder != nil {
+					decoder.Decode(m)
+				}
+				msgs = append(msgs, newDLQMessage(m))
+				return len(msgs) < n, nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(msgs).
+		WithTextFunc(func(w io.Writer) error {
+			var sb strings.Builder
+			for i, m := range msgs {
+				if i != 0 {
+					sb.WriteString(message.Separator + "\n")
+				}
+				fmt.Fprintf(&sb, "%-17s: %s\n", "Real Topic", m.RealTopic)
+				fmt.Fprintf(&sb, "%-17s: %s\n", "Origin Message ID", m.OriginMessageID)
+				if err := message.WriteText(&sb, []*message.Message{m.Message}); err != nil {
+					return err
+				}
+			}
+			_, err := io.WriteString(w, sb.String())
+			return err
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
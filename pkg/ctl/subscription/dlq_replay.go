@@ -0,0 +1,340 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+type dlqReplayOptions struct {
+	subscription string
+	toTopic      string
+	messageIDs   []string
+	key          string
+	properties   []string
+	count        int
+	dryRun       bool
+	timeout      time.Duration
+	serviceURL   string
+}
+
+// dlqFilter selects the dead letter messages to replay
+type dlqFilter struct {
+	ids        []pulsar.MessageID
+	originIDs  map[string]bool
+	key        *regexp.Regexp
+	properties map[string]string
+}
+
+func DLQReplayCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for republishing the messages of a dead letter topic to the " +
+		"topic they were originally published to, which is read from the REAL_TOPIC property of each message. " +
+		"Each replayed message is acknowledged on the given subscription of the dead letter topic, the messages " +
+		"that do not match the filters are left unacknowledged. The replay stops at the last message published " +
+		"before it started, or when no message is received within the timeout."
+	desc.CommandPermission = "This command requires namespace produce and consume permissions."
+
+	var examples []cmdutils.Example
+	replay := cmdutils.Example{
+		Desc:    "Replay all the messages of a dead letter topic (dlq-topic-name)",
+		Command: "pulsarctl subscriptions dlq replay --subscription (subscription-name) (dlq-topic-name)",
+	}
+	replayDryRun := cmdutils.Example{
+		Desc: "List the messages of a dead letter topic (dlq-topic-name) with a key matching a regular " +
+			"expression that would be replayed",
+		Command: "pulsarctl subscriptions dlq replay --key 'order-.*' --dry-run (dlq-topic-name)",
+	}
+	replayIDs := cmdutils.Example{
+		Desc: "Replay the messages of a dead letter topic (dlq-topic-name) with the given message ids " +
+			"to another topic",
+		Command: "pulsarctl subscriptions dlq replay --subscription (subscription-name) --message-id 10:1 " +
+			"--message-id 10:2 --to-topic (topic-name) (dlq-topic-name)",
+	}
+	examples = append(examples, replay, replayDryRun, replayIDs)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "Replayed (message-id) to (topic-name)\n" +
+			"Replayed (n) messages of the dead letter topic (dlq-topic-name)",
+	}
+	dryRunOut := cmdutils.Output{
+		Desc: "dry run output",
+		Out: "Would replay (message-id) to (topic-name)\n" +
+			"(n) messages of the dead letter topic (dlq-topic-name) would be replayed",
+	}
+	noSubError := cmdutils.Output{
+		Desc: "the subscription name is not specified",
+		Out:  "[✖]  the subscription name is not specified",
+	}
+	out = append(out, successOut, dryRunOut, ArgError, noSubError)
+	out = append(out, TopicNameErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"replay",
+		"Republish the messages of a dead letter topic to their original topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	opts := dlqReplayOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doDLQReplay(vc, &opts)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("DLQ Replay", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.subscription, "subscription", "",
+			"The subscription of the dead letter topic to acknowledge the replayed messages on, "+
+				"it is not required with --dry-run")
+		set.StringVar(&opts.toTopic, "to-topic", "",
+			"The topic to replay the messages to instead of their original topic")
+		set.StringSliceVar(&opts.messageIDs, "message-id", nil,
+			"Replay only the messages with the given message ids (ledgerID:entryID[:partitionIndex[:batchIndex]]), "+
+				"either in the dead letter topic or in the original topic")
+		set.StringVar(&opts.key, "key", "", "Replay only the messages with a key matching the regular expression")
+		set.StringSliceVar(&opts.properties, "property", nil,
+			"Replay only the messages with the given property (key=value)")
+		set.IntVarP(&opts.count, "count", "n", 0, "The maximum number of messages to replay, 0 means no limit")
+		set.BoolVar(&opts.dryRun, "dry-run", false,
+			"Print the messages that would be replayed without publishing or acknowledging them, "+
+				"the dead letter topic is read from the earliest message without using the subscription")
+		set.DurationVar(&opts.timeout, "timeout", 5*time.Second,
+			"Stop replaying when no message is received within the timeout")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+}
+
+func doDLQReplay(vc *cmdutils.VerbCmd, opts *dlqReplayOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if opts.subscription == "" && !opts.dryRun {
+		return errors.New("the subscription name is not specified")
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	filter, err := newDLQFilter(opts)
+	if err != nil {
+		return err
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	consumer, err := client.Subscribe(dlqConsumerOptions(topic.String(), opts))
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	lastIDs, err := consumer.GetLastMessageIDs()
+	if err != nil {
+		return err
+	}
+	pending := make(map[string]pulsar.MessageID, len(lastIDs))
+	for _, id := range lastIDs {
+		if id.EntryID() >= 0 {
+			pending[id.Topic()] = id
+		}
+	}
+
+	producers := make(map[string]pulsar.Producer)
+	defer func() {
+		for _, p := range producers {
+			p.Close()
+		}
+	}()
+
+	replayed := 0
+	for len(pending) > 0 && (opts.count <= 0 || replayed < opts.count) {
+		ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+		msg, err := consumer.Receive(ctx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		last, ok := pending[msg.Topic()]
+		if !ok || message.CompareMessageID(msg.ID(), last) > 0 {
+			// published after the replay started
+			delete(pending, msg.Topic())
+			continue
+		}
+		if message.CompareMessageID(msg.ID(), last) == 0 {
+			delete(pending, msg.Topic())
+		}
+
+		m := message.FromPulsarMessage(msg)
+		if !filter.match(msg.ID(), m) {
+			continue
+		}
+
+		dest := opts.toTopic
+		if dest == "" {
+			dest = m.Properties[pulsar.SysPropertyRealTopic]
+		}
+		if dest == "" {
+			vc.Command.Printf("Skipped %s which has no %s property\n", m.MessageID, pulsar.SysPropertyRealTopic)
+			continue
+		}
+
+		replayed++
+		if opts.dryRun {
+			vc.Command.Printf("Would replay %s to %s\n", m.MessageID, dest)
+			continue
+		}
+
+		producer, ok := producers[dest]
+		if !ok {
+			producer, err = client.CreateProducer(pulsar.ProducerOptions{Topic: dest})
+			if err != nil {
+				return err
+			}
+			producers[dest] = producer
+		}
+
+		pm := &pulsar.ProducerMessage{
+			Payload:     msg.Payload(),
+			Key:         msg.Key(),
+			OrderingKey: msg.OrderingKey(),
+			Properties:  replayProperties(msg.Properties()),
+			EventTime:   msg.EventTime(),
+		}
+		if _, err = producer.Send(context.Background(), pm); err != nil {
+			return errors.Wrapf(err, "failed to replay %s to %s", m.MessageID, dest)
+		}
+		if err = consumer.Ack(msg); err != nil {
+			return errors.Wrapf(err, "failed to acknowledge %s", m.MessageID)
+		}
+		vc.Command.Printf("Replayed %s to %s\n", m.MessageID, dest)
+	}
+
+	if opts.dryRun {
+		vc.Command.Printf("%d messages of the dead letter topic %s would be replayed\n", replayed, topic.String())
+	} else {
+		vc.Command.Printf("Replayed %d messages of the dead letter topic %s\n", replayed, topic.String())
+	}
+	return nil
+}
+
+// dlqConsumerOptions returns the options of the consumer reading the dead letter topic. A dry run
+// reads from the earliest message on a non-durable subscription of its own, so it neither creates
+// the subscription nor takes messages from its consumers.
+func dlqConsumerOptions(topic string, opts *dlqReplayOptions) pulsar.ConsumerOptions {
+	if opts.dryRun {
+		return pulsar.ConsumerOptions{
+			Topic:                       topic,
+			SubscriptionName:            fmt.Sprintf("pulsarctl-dlq-replay-dry-run-%d", time.Now().UnixNano()),
+			SubscriptionMode:            pulsar.NonDurable,
+			Type:                        pulsar.Exclusive,
+			SubscriptionInitialPosition: pulsar.SubscriptionPositionEarliest,
+		}
+	}
+	return pulsar.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            opts.subscription,
+		Type:                        pulsar.Shared,
+		SubscriptionInitialPosition: pulsar.SubscriptionPositionEarliest,
+	}
+}
+
+func newDLQFilter(opts *dlqReplayOptions) (*dlqFilter, error) {
+	f := &dlqFilter{
+		originIDs:  make(map[string]bool, len(opts.messageIDs)),
+		properties: make(map[string]string, len(opts.properties)),
+	}
+
+	for _, s := range opts.messageIDs {
+		id, err := ctlutils.ParseMessageID(s)
+		if err != nil {
+			return nil, err
+		}
+		f.ids = append(f.ids, id)
+		f.originIDs[s] = true
+	}
+
+	if opts.key != "" {
+		key, err := regexp.Compile(opts.key)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid key regular expression")
+		}
+		f.key = key
+	}
+
+	for _, p := range opts.properties {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid property '%s', it should be in the format of key=value", p)
+		}
+		f.properties[kv[0]] = kv[1]
+	}
+	return f, nil
+}
+
+func (f *dlqFilter) match(id pulsar.MessageID, m *message.Message) bool {
+	if len(f.ids) > 0 && !f.originIDs[m.Properties[pulsar.PropertyOriginMessageID]] {
+		found := false
+		for _, want := range f.ids {
+			if message.CompareMessageID(id, want) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.key != nil && !f.key.MatchString(m.Key) {
+		return false
+	}
+
+	for k, v := range f.properties {
+		if m.Properties[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+func TestFindDLQTopics(t *testing.T) {
+	topics := []string{
+		"persistent://public/default/orders",
+		"persistent://public/default/orders-billing-DLQ",
+		"persistent://public/default/orders-eu",
+		"persistent://public/default/orders-eu-billing-RETRY-partition-0",
+		"persistent://public/default/orders-eu-billing-RETRY-partition-1",
+		"persistent://public/default/unknown-sub-DLQ",
+	}
+
+	dlqs := findDLQTopics(topics)
+	sort.Slice(dlqs, func(i, j int) bool {
+		return dlqs[i].Topic < dlqs[j].Topic
+	})
+	assert.Equal(t, []dlqTopic{
+		{
+			Topic:        "persistent://public/default/orders-billing-DLQ",
+			Type:         "DLQ",
+			OriginTopic:  "persistent://public/default/orders",
+			Subscription: "billing",
+		},
+		{
+			Topic:        "persistent://public/default/orders-eu-billing-RETRY",
+			Type:         "RETRY",
+			OriginTopic:  "persistent://public/default/orders-eu",
+			Subscription: "billing",
+		},
+		{
+			Topic: "persistent://public/default/unknown-sub-DLQ",
+			Type:  "DLQ",
+		},
+	}, dlqs)
+}
+
+func TestDLQFilter(t *testing.T) {
+	f, err := newDLQFilter(&dlqReplayOptions{
+		messageIDs: []string{"10:1", "20:3:-1:-1"},
+		key:        "^order-",
+		properties: []string{"region=eu"},
+	})
+	assert.Nil(t, err)
+
+	m := &message.Message{
+		Key: "order-1",
+		Properties: map[string]string{
+			"region":                       "eu",
+			pulsar.PropertyOriginMessageID: "20:3:-1:-1",
+		},
+	}
+	assert.True(t, f.match(pulsar.NewMessageID(10, 1, -1, 0), m))
+	assert.True(t, f.match(pulsar.NewMessageID(11, 1, -1, 0), m))
+
+	m.Properties[pulsar.PropertyOriginMessageID] = "30:1:-1:-1"
+	assert.False(t, f.match(pulsar.NewMessageID(11, 1, -1, 0), m))
+
+	m.Key = "invoice-1"
+	assert.False(t, f.match(pulsar.NewMessageID(10, 1, -1, 0), m))
+
+	m.Key = "order-1"
+	m.Properties["region"] = "us"
+	assert.False(t, f.match(pulsar.NewMessageID(10, 1, -1, 0), m))
+
+	_, err = newDLQFilter(&dlqReplayOptions{properties: []string{"region"}})
+	assert.NotNil(t, err)
+}
+
+func TestReplayProperties(t *testing.T) {
+	props := replayProperties(map[string]string{
+		"region":                       "eu",
+		pulsar.SysPropertyRealTopic:    "persistent://public/default/orders",
+		pulsar.PropertyOriginMessageID: "10:1:-1:-1",
+	})
+	assert.Equal(t, map[string]string{"region": "eu"}, props)
+}
+
+func TestDLQListArgsError(t *testing.T) {
+	_, _, nameErr, _ := TestSubCommands(DLQListCmd, []string{"list"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the namespace name is not specified or the namespace name is specified more than one",
+		nameErr.Error())
+}
+
+func TestDLQPeekArgsError(t *testing.T) {
+	_, _, nameErr, _ := TestSubCommands(DLQPeekCmd, []string{"peek"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestDLQReplayNoSubscription(t *testing.T) {
+	_, execErr, _, _ := TestSubCommands(DLQReplayCmd, []string{"replay", "orders-billing-DLQ"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the subscription name is not specified", execErr.Error())
+}
+
+func TestDLQConsumerOptions(t *testing.T) {
+	opts := &dlqReplayOptions{subscription: "billing"}
+	o := dlqConsumerOptions("orders-billing-DLQ", opts)
+	assert.Equal(t, "billing", o.SubscriptionName)
+	assert.Equal(t, pulsar.Durable, o.SubscriptionMode)
+
+	opts.dryRun = true
+	o = dlqConsumerOptions("orders-billing-DLQ", opts)
+	assert.NotEqual(t, "billing", o.SubscriptionName)
+	assert.Equal(t, pulsar.NonDurable, o.SubscriptionMode)
+	assert.Equal(t, pulsar.SubscriptionPositionEarliest, o.SubscriptionInitialPosition)
+}
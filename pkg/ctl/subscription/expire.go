@@ -19,6 +19,8 @@ package subscription
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/pkg/errors"
@@ -26,12 +28,15 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
 )
 
 func ExpireCmd(vc *cmdutils.VerbCmd) {
 	var desc cmdutils.LongDescription
 	desc.CommandUsedFor = "This command is used for expiring messages that older than given expiry time (in seconds)" +
-		" for a subscription."
+		" for a subscription. The expiry time can also be a relative time such as 1h or an RFC3339 timestamp. " +
+		"The messages are expired on all the partitions of a partitioned topic concurrently, and the " +
+		"resulting position and backlog change of each partition are reported."
 	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
 
 	var examples []cmdutils.Example
@@ -41,21 +46,37 @@ func ExpireCmd(vc *cmdutils.VerbCmd) {
 		Command: "pulsarctl subscription expire --expire-time (expire-time) (topic-name) (subscription-name)",
 	}
 
+	expireRelative := cmdutils.Example{
+		Desc:    "Expire messages that older than one hour for a subscription (subscription-name) under a topic",
+		Command: "pulsarctl subscription expire --expire-time 1h (topic-name) (subscription-name)",
+	}
+
+	expireRFC3339 := cmdutils.Example{
+		Desc: "Expire messages that published before an RFC3339 timestamp for a subscription " +
+			"(subscription-name) under a topic",
+		Command: "pulsarctl subscription expire --expire-time 2024-01-02T15:04:05Z (topic-name) (subscription-name)",
+	}
+
 	expireAllSub := cmdutils.Example{
 		Desc: "Expire message that older than given expire time (in second) for all subscriptions " +
 			"under a topic",
 		Command: "pulsarctl subscriptions expire --all --expire-time (expire-time) (topic-name)",
 	}
-	examples = append(examples, expire, expireAllSub)
+	examples = append(examples, expire, expireRelative, expireRFC3339, expireAllSub)
 	desc.CommandExamples = examples
 
 	var out []cmdutils.Output
 	successOut := cmdutils.Output{
 		Desc: "normal output",
-		Out: "Expire messages after (time)(s) for the subscription (subscription-name) of the topic (topic-name) " +
+		Out: "(the position and the backlog change of each partition)\n" +
+			"Expire messages after (time)(s) for the subscription (subscription-name) of the topic (topic-name) " +
 			"successfully",
 	}
-	out = append(out, successOut, ArgsError, TopicNotFoundError)
+	expireTimeError := cmdutils.Output{
+		Desc: "the expire time is in the future",
+		Out:  "[✖]  the expire time (expire-time) is in the future",
+	}
+	out = append(out, successOut, ArgsError, expireTimeError, TopicNotFoundError)
 	out = append(out, TopicNameErrors...)
 	out = append(out, NamespaceErrors...)
 	desc.CommandOutput = out
@@ -66,11 +87,11 @@ func ExpireCmd(vc *cmdutils.VerbCmd) {
 		desc.ToString(),
 		"expire")
 
-	var time int64
+	var expireTime string
 	var all bool
 
 	vc.SetRunFuncWithMultiNameArgs(func() error {
-		return doExpire(vc, time, all)
+		return doExpire(vc, expireTime, all)
 	}, func(args []string) error {
 		if len(args) > 2 || len(args) < 1 {
 			return errors.New("need to specified the topic name and the subscription name")
@@ -79,20 +100,26 @@ func ExpireCmd(vc *cmdutils.VerbCmd) {
 	})
 
 	vc.FlagSetGroup.InFlagSet("ExpireMessages", func(set *pflag.FlagSet) {
-		set.Int64VarP(&time, "expire-time", "t", 0,
-			"Expire messages older than time in seconds")
+		set.StringVarP(&expireTime, "expire-time", "t", "",
+			"Expire messages older than time in seconds, a relative time (e.g. 30m, 1h, 2d) "+
+				"or an RFC3339 timestamp")
 		_ = cobra.MarkFlagRequired(set, "expire-time")
 		set.BoolVarP(&all, "all", "a", false, "Expire all messages")
 	})
 	vc.EnableOutputFlagSet()
 }
 
-func doExpire(vc *cmdutils.VerbCmd, time int64, all bool) error {
+func doExpire(vc *cmdutils.VerbCmd, expireTime string, all bool) error {
 	// for testing
 	if vc.NameError != nil {
 		return vc.NameError
 	}
 
+	seconds, err := parseExpireTime(expireTime, time.Now())
+	if err != nil {
+		return err
+	}
+
 	topic, err := utils.GetTopicName(vc.NameArgs[0])
 	if err != nil {
 		return err
@@ -107,21 +134,39 @@ func doExpire(vc *cmdutils.VerbCmd, time int64, all bool) error {
 	}
 
 	admin := cmdutils.NewPulsarClient()
-	if all {
-		err = admin.Subscriptions().ExpireAllMessages(*topic, time)
-	} else {
-		err = admin.Subscriptions().ExpireMessages(*topic, sName, time)
+	partitions, err := cmdutils.TopicPartitions(admin.Topics(), *topic)
+	if err != nil {
+		return err
 	}
 
-	if err == nil {
-		out := fmt.Sprintf("Expire messages after %d(s) for the subscription %s of the topic %s successfully",
-			time, sName, topic.String())
+	changes := moveCursors(admin.Topics(), partitions, sName, func(partition utils.TopicName) error {
 		if all {
-			out = fmt.Sprintf("Expire messages after %d(s) for all the subscriptions of the topic %s "+
-				"successfully", time, topic.String())
+			return admin.Subscriptions().ExpireAllMessages(partition, seconds)
 		}
-		vc.Command.Println(out)
+		return admin.Subscriptions().ExpireMessages(partition, sName, seconds)
+	})
+
+	out := fmt.Sprintf("Expire messages after %d(s) for the subscription %s of the topic %s successfully",
+		seconds, sName, topic.String())
+	if all {
+		out = fmt.Sprintf("Expire messages after %d(s) for all the subscriptions of the topic %s "+
+			"successfully", seconds, topic.String())
 	}
+	return writeCursorChanges(vc, changes, out)
+}
 
-	return err
+// parseExpireTime parses the expire time in seconds, a plain number is the number of seconds
+// while a relative time or a timestamp is converted to the seconds elapsed since then
+func parseExpireTime(s string, now time.Time) (int64, error) {
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return seconds, nil
+	}
+	t, err := ctlutils.ParseTime(s, now)
+	if err != nil {
+		return 0, err
+	}
+	if t.After(now) {
+		return 0, errors.Errorf("the expire time %s is in the future", s)
+	}
+	return int64(now.Sub(t) / time.Second), nil
 }
@@ -18,28 +18,23 @@
 package subscription
 
 import (
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
 )
 
-type readMessage struct {
-	Properties      map[string]string `json:"properties"`
-	MessageID       utils.MessageID   `json:"messageId"`
-	Payload         []byte            `json:"payload"`
-	PayloadAsString string            `json:"PayloadString"`
-}
-
 func GetMessageByIDCmd(vc *cmdutils.VerbCmd) {
 	var desc cmdutils.LongDescription
 	desc.CommandUsedFor = "This command is used for getting messages by the given ledgerID and entryID" +
-		" for a subscription."
+		" for a subscription. The redelivery count is not stored with the message, so it is not shown."
 	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
 
 	var examples []cmdutils.Example
@@ -47,6 +42,16 @@ func GetMessageByIDCmd(vc *cmdutils.VerbCmd) {
 		Desc:    "Get message by the given ledgerID an entryID",
 		Command: "pulsarctl subscription get-message-by-id --ledger-id (ledger-id) --entry-id (entry-id) (topic-name)",
 	})
+	examples = append(examples, cmdutils.Example{
+		Desc: "Get the message at the given batch index of a batched entry",
+		Command: "pulsarctl subscription get-message-by-id --ledger-id (ledger-id) --entry-id (entry-id) " +
+			"--batch-index (batch-index) (topic-name)",
+	})
+	examples = append(examples, cmdutils.Example{
+		Desc: "Get message by the given ledgerID an entryID without decoding the payload with the topic schema",
+		Command: "pulsarctl subscription get-message-by-id --ledger-id (ledger-id) --entry-id (entry-id) " +
+			"--raw (topic-name)",
+	})
 	desc.CommandExamples = examples
 
 	vc.SetDescription(
@@ -58,9 +63,11 @@ func GetMessageByIDCmd(vc *cmdutils.VerbCmd) {
 
 	var ledgerID int64
 	var entryID int64
+	var batchIndex int
+	var raw bool
 
 	vc.SetRunFuncWithNameArg(func() error {
-		return doGetMessageByID(vc, ledgerID, entryID)
+		return doGetMessageByID(vc, ledgerID, entryID, batchIndex, raw)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
 	vc.FlagSetGroup.InFlagSet("GetMessageByID", func(set *pflag.FlagSet) {
@@ -68,11 +75,13 @@ func GetMessageByIDCmd(vc *cmdutils.VerbCmd) {
 		_ = cobra.MarkFlagRequired(set, "ledger-id")
 		set.Int64VarP(&entryID, "entry-id", "e", 0, "entry id pointing to the desired entry")
 		_ = cobra.MarkFlagRequired(set, "entry-id")
+		set.IntVarP(&batchIndex, "batch-index", "b", 0, "batch index of the desired message in a batched entry")
+		set.BoolVar(&raw, "raw", false, "Print the payload as a hex dump instead of decoding it with the topic schema")
 	})
 	vc.EnableOutputFlagSet()
 }
 
-func doGetMessageByID(vc *cmdutils.VerbCmd, ledgerID int64, entryID int64) error {
+func doGetMessageByID(vc *cmdutils.VerbCmd, ledgerID int64, entryID int64, batchIndex int, raw bool) error {
 	// for testing
 	if vc.NameError != nil {
 		return vc.NameError
@@ -83,35 +92,75 @@ func doGetMessageByID(vc *cmdutils.VerbCmd, ledgerID int64, entryID int64) error
 		return err
 	}
 
-	client := cmdutils.NewPulsarClient()
-	messages, err := client.Subscriptions().GetMessagesByID(*topic, ledgerID, entryID)
+	rc := cmdutils.NewPulsarRestClient()
+	messages, err := fetchMessages(rc, *topic,
+		"ledger", strconv.FormatInt(ledgerID, 10), "entry", strconv.FormatInt(entryID, 10))
 	if err != nil {
 		return err
 	}
 	if len(messages) == 0 {
 		return fmt.Errorf("no message found with the given ledgerID and entryID")
 	}
-	message := messages[0]
 
-	propertiesJSON, err := json.Marshal(message.GetProperties())
+	if batchIndex < 0 || batchIndex >= len(messages) {
+		return fmt.Errorf("the batch index %d is out of range, the entry has %d messages", batchIndex, len(messages))
+	}
+	msg := messages[batchIndex]
+	if !raw {
+		decodeMessages(*topic, []*message.Message{msg})
+	}
+
+	result, err := newMessageByID(msg)
 	if err != nil {
 		return err
 	}
-
-	//nolint:govet
 	oc := cmdutils.NewOutputContent().
-		WithObject(&readMessage{
-			MessageID:       message.GetMessageID(),
-			Properties:      message.GetProperties(),
-			Payload:         message.Payload,
-			PayloadAsString: string(message.Payload),
-		}).
-		WithText(`Message ID: %s
-		Properties: %s
-		Message: 
-				%s`, message.GetMessageID(), propertiesJSON, hex.Dump(message.Payload))
-
-	err = vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
-
-	return err
+		WithObject(result).
+		WithTextFunc(func(w io.Writer) error {
+			return message.WriteText(w, []*message.Message{msg})
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+// MessageByID is the message written by get-message-by-id in JSON and YAML, it keeps the
+// messageId, properties, payload and PayloadString fields of the previous output along with
+// the metadata of the message
+type MessageByID struct {
+	MessageID     utils.MessageID   `json:"messageId"`
+	BatchIndex    int               `json:"batchIndex"`
+	BatchSize     int               `json:"batchSize,omitempty"`
+	PublishTime   time.Time         `json:"publishTime"`
+	EventTime     *time.Time        `json:"eventTime,omitempty"`
+	Key           string            `json:"key,omitempty"`
+	ProducerName  string            `json:"producerName,omitempty"`
+	SequenceID    int64             `json:"sequenceId"`
+	SchemaVersion *int64            `json:"schemaVersion,omitempty"`
+	Properties    map[string]string `json:"properties"`
+	Payload       []byte            `json:"payload"`
+	PayloadString string            `json:"PayloadString"`
+	Value         interface{}       `json:"value,omitempty"`
+	DecodeError   string            `json:"decodeError,omitempty"`
+}
+
+func newMessageByID(m *message.Message) (*MessageByID, error) {
+	id, err := utils.ParseMessageID(m.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageByID{
+		MessageID:     *id,
+		BatchIndex:    m.BatchIndex,
+		BatchSize:     m.BatchSize,
+		PublishTime:   m.PublishTime,
+		EventTime:     m.EventTime,
+		Key:           m.Key,
+		ProducerName:  m.ProducerName,
+		SequenceID:    m.SequenceID,
+		SchemaVersion: m.SchemaVersion,
+		Properties:    m.Properties,
+		Payload:       m.Payload,
+		PayloadString: string(m.Payload),
+		Value:         m.Value,
+		DecodeError:   m.DecodeError,
+	}, nil
 }
@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func GetPropertiesCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for getting the properties of a subscription."
+	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
+
+	var examples []cmdutils.Example
+	get := cmdutils.Example{
+		Desc:    "Get the properties of the subscription (subscription-name) of the topic (topic-name)",
+		Command: "pulsarctl subscriptions get-properties (topic-name) (subscription-name)",
+	}
+	examples = append(examples, get)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"env\": \"prod\",\n" +
+			"  \"team\": \"payments\"\n" +
+			"}",
+	}
+	out = append(out, successOut, ArgsError, TopicNotFoundError, SubNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-properties",
+		"Get the properties of a subscription",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"get-properties")
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doGetProperties(vc)
+	}, CheckSubscriptionNameTwoArgs)
+
+	vc.EnableOutputFlagSet()
+}
+
+func doGetProperties(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArgs[0])
+	if err != nil {
+		return err
+	}
+
+	properties, err := getSubscriptionProperties(cmdutils.NewPulsarRestClient(), topic, vc.NameArgs[1])
+	if err == nil {
+		oc := cmdutils.NewOutputContent().WithObject(properties)
+		err = vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+	}
+	return err
+}
+
+func getSubscriptionProperties(rc *cmdutils.RestClient, topic *utils.TopicName,
+	sub string) (map[string]string, error) {
+	properties := make(map[string]string)
+	err := rc.Get(rc.Endpoint("", topic.GetRestPath(), "subscription", sub, "properties"), &properties)
+	if err != nil {
+		return nil, err
+	}
+	if properties == nil {
+		properties = make(map[string]string)
+	}
+	return properties, nil
+}
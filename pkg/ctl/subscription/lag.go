@@ -0,0 +1,336 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+type lagOptions struct {
+	maxBacklog     int64
+	maxBacklogSize string
+	maxAge         time.Duration
+	maxIdle        time.Duration
+	onlyLagging    bool
+	concurrency    int
+}
+
+// lagThresholds are the limits a subscription is flagged as lagging beyond, a zero
+// limit is not checked
+type lagThresholds struct {
+	backlog     int64
+	backlogSize int64
+	age         time.Duration
+	idle        time.Duration
+}
+
+// SubscriptionLag is the lag of a subscription
+type SubscriptionLag struct {
+	Topic             string   `json:"topic"`
+	Subscription      string   `json:"subscription"`
+	Type              string   `json:"type"`
+	Consumers         int      `json:"consumers"`
+	MsgBacklog        int64    `json:"msgBacklog"`
+	BacklogSize       int64    `json:"backlogSize"`
+	UnackedMessages   int64    `json:"unackedMessages"`
+	LastConsumedTime  int64    `json:"lastConsumedTimestamp"`
+	OldestBacklogTime int64    `json:"earliestMsgPublishTimeInBacklog"`
+	OldestBacklogAge  string   `json:"oldestBacklogAge,omitempty"`
+	Lagging           bool     `json:"lagging"`
+	Reasons           []string `json:"reasons,omitempty"`
+}
+
+func LagCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for reporting the backlog and the lag of every subscription of " +
+		"the topics in a namespace or a tenant. A subscription is flagged as lagging if its backlog, its " +
+		"backlog size, the age of its oldest message in the backlog or the time since a message is last " +
+		"consumed exceeds the threshold. The stats of a partitioned topic are aggregated over its partitions."
+	desc.CommandPermission = "This command requires namespace admin permissions."
+
+	var examples []cmdutils.Example
+	lagNs := cmdutils.Example{
+		Desc:    "Report the lag of the subscriptions in a namespace (tenant/namespace)",
+		Command: "pulsarctl subscriptions lag (tenant/namespace)",
+	}
+	lagTenant := cmdutils.Example{
+		Desc: "Report the subscriptions in a tenant (tenant) with a backlog of more than 10000 messages " +
+			"or an oldest message in the backlog older than 10 minutes",
+		Command: "pulsarctl subscriptions lag (tenant) --max-backlog 10000 --max-age 10m --only-lagging",
+	}
+	examples = append(examples, lagNs, lagTenant)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+-------------------------------+--------------+-----------+---------+---------+---------+----------------------+------------+-----------------------------+
+|             TOPIC             | SUBSCRIPTION | CONSUMERS | BACKLOG |  BYTES  | UNACKED |    LAST CONSUMED     | OLDEST AGE |           STATUS            |
++-------------------------------+--------------+-----------+---------+---------+---------+----------------------+------------+-----------------------------+
+| persistent://public/default/t | my-sub       |         1 |   10000 | 1048576 |       0 | 2020-01-01T00:00:00Z | 15m0s      | LAGGING: oldest age > 10m0s |
++-------------------------------+--------------+-----------+---------+---------+---------+----------------------+------------+-----------------------------+`,
+	}
+	argError := cmdutils.Output{
+		Desc: "the tenant or the namespace is not specified",
+		Out:  "[✖]  the tenant or the namespace name is not specified or specified more than one",
+	}
+	out = append(out, successOut, argError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"lag",
+		"Report the backlog and the lag of the subscriptions in a namespace or a tenant",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	opts := lagOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doLag(vc, &opts)
+	}, "the tenant or the namespace name is not specified or specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Lag", func(set *pflag.FlagSet) {
+		set.Int64Var(&opts.maxBacklog, "max-backlog", 0,
+			"Flag the subscriptions with more messages in the backlog, 0 disables the check")
+		set.StringVar(&opts.maxBacklogSize, "max-backlog-size", "",
+			"Flag the subscriptions with a larger backlog size, e.g. 10M or 1G")
+		set.DurationVar(&opts.maxAge, "max-age", 0,
+			"Flag the subscriptions with an older message in the backlog, 0 disables the check")
+		set.DurationVar(&opts.maxIdle, "max-idle", 0,
+			"Flag the subscriptions with a backlog which have not consumed a message for longer, "+
+				"0 disables the check")
+		set.BoolVar(&opts.onlyLagging, "only-lagging", false, "Report only the lagging subscriptions")
+		set.IntVar(&opts.concurrency, "concurrency", 8, "The number of the topic stats fetched concurrently")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doLag(vc *cmdutils.VerbCmd, opts *lagOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	thresholds := lagThresholds{backlog: opts.maxBacklog, age: opts.maxAge, idle: opts.maxIdle}
+	if opts.maxBacklogSize != "" {
+		size, err := ctlutils.ValidateSizeString(opts.maxBacklogSize)
+		if err != nil {
+			return errors.Wrapf(err, "invalid backlog size '%s'", opts.maxBacklogSize)
+		}
+		thresholds.backlogSize = size
+	}
+	if opts.concurrency <= 0 {
+		return errors.New("the concurrency must be greater than 0")
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	namespaces := []string{vc.NameArg}
+	if !strings.Contains(vc.NameArg, "/") {
+		var err error
+		namespaces, err = admin.Namespaces().GetNamespaces(vc.NameArg)
+		if err != nil {
+			return err
+		}
+	}
+
+	var topics []string
+	partitioned := make(map[string]bool)
+	for _, n := range namespaces {
+		ns, err := utils.GetNamespaceName(n)
+		if err != nil {
+			return err
+		}
+		p, np, err := admin.Topics().List(*ns)
+		if err != nil {
+			return err
+		}
+		for _, t := range p {
+			partitioned[t] = true
+			topics = append(topics, t)
+		}
+		for _, t := range np {
+			if partitioned[partitionedTopicName(t)] && partitionedTopicName(t) != t {
+				continue
+			}
+			topics = append(topics, t)
+		}
+	}
+
+	now := time.Now()
+	var lags []*SubscriptionLag
+	for _, r := range fetchTopicStats(admin.Topics(), topics, partitioned, opts.concurrency) {
+		if r.err != nil {
+			vc.Command.PrintErrf("Failed to get the stats of the topic %s: %v\n", r.topic, r.err)
+			continue
+		}
+		for name, sub := range r.subs {
+			l := newSubscriptionLag(r.topic, name, sub, now, thresholds)
+			if !opts.onlyLagging || l.Lagging {
+				lags = append(lags, l)
+			}
+		}
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].MsgBacklog != lags[j].MsgBacklog {
+			return lags[i].MsgBacklog > lags[j].MsgBacklog
+		}
+		if lags[i].Topic != lags[j].Topic {
+			return lags[i].Topic < lags[j].Topic
+		}
+		return lags[i].Subscription < lags[j].Subscription
+	})
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(lags).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Subscription", "Consumers", "Backlog", "Bytes", "Unacked",
+				"Last Consumed", "Oldest Age", "Status"})
+			for _, l := range lags {
+				status := "OK"
+				if l.Lagging {
+					status = "LAGGING: " + strings.Join(l.Reasons, ", ")
+				}
+				table.Append([]string{
+					l.Topic,
+					l.Subscription,
+					strconv.Itoa(l.Consumers),
+					strconv.FormatInt(l.MsgBacklog, 10),
+					strconv.FormatInt(l.BacklogSize, 10),
+					strconv.FormatInt(l.UnackedMessages, 10),
+					formatTimestamp(l.LastConsumedTime),
+					l.OldestBacklogAge,
+					status,
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+type topicStatsResult struct {
+	topic string
+	subs  map[string]utils.SubscriptionStats
+	err   error
+}
+
+// fetchTopicStats fetches the subscription stats of the topics with a bounded number of
+// concurrent requests, including the publish time of the oldest message in each backlog
+func fetchTopicStats(topics admin.Topics, names []string, partitioned map[string]bool,
+	concurrency int) []topicStatsResult {
+	results := make([]topicStatsResult, len(names))
+	option := utils.GetStatsOptions{
+		SubscriptionBacklogSize:  true,
+		GetEarliestTimeInBacklog: true,
+		ExcludePublishers:        true,
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				r := &results[i]
+				r.topic = names[i]
+				topic, err := utils.GetTopicName(names[i])
+				if err != nil {
+					r.err = err
+					continue
+				}
+				if partitioned[names[i]] {
+					s, err := topics.GetPartitionedStatsWithOption(*topic, false, option)
+					r.subs, r.err = s.Subscriptions, err
+				} else {
+					s, err := topics.GetStatsWithOption(*topic, option)
+					r.subs, r.err = s.Subscriptions, err
+				}
+			}
+		}()
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+func newSubscriptionLag(topic, name string, sub utils.SubscriptionStats, now time.Time,
+	t lagThresholds) *SubscriptionLag {
+	l := &SubscriptionLag{
+		Topic:             topic,
+		Subscription:      name,
+		Type:              sub.SubType,
+		Consumers:         len(sub.Consumers),
+		MsgBacklog:        sub.MsgBacklog,
+		BacklogSize:       sub.BacklogSize,
+		UnackedMessages:   sub.UnAckedMessages,
+		LastConsumedTime:  sub.LastConsumedTimestamp,
+		OldestBacklogTime: sub.EarliestMsgPublishTimeInBacklog,
+	}
+
+	var age time.Duration
+	if sub.MsgBacklog > 0 && sub.EarliestMsgPublishTimeInBacklog > 0 {
+		age = now.Sub(time.UnixMilli(sub.EarliestMsgPublishTimeInBacklog)).Truncate(time.Second)
+		l.OldestBacklogAge = age.String()
+	}
+
+	if t.backlog > 0 && l.MsgBacklog > t.backlog {
+		l.Reasons = append(l.Reasons, fmt.Sprintf("backlog > %d", t.backlog))
+	}
+	if t.backlogSize > 0 && l.BacklogSize > t.backlogSize {
+		l.Reasons = append(l.Reasons, fmt.Sprintf("backlog size > %d", t.backlogSize))
+	}
+	if t.age > 0 && age > t.age {
+		l.Reasons = append(l.Reasons, fmt.Sprintf("oldest age > %v", t.age))
+	}
+	if t.idle > 0 && l.MsgBacklog > 0 {
+		// a subscription which never consumed is idle since ever
+		if l.LastConsumedTime <= 0 || now.Sub(time.UnixMilli(l.LastConsumedTime)) > t.idle {
+			l.Reasons = append(l.Reasons, fmt.Sprintf("idle > %v", t.idle))
+		}
+	}
+	l.Lagging = len(l.Reasons) > 0
+	return l
+}
+
+func formatTimestamp(ms int64) string {
+	if ms <= 0 {
+		return "never"
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
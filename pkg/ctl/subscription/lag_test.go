@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSubscriptionLag(t *testing.T) {
+	now := time.Now()
+	sub := utils.SubscriptionStats{
+		SubType:                         "Shared",
+		MsgBacklog:                      200,
+		BacklogSize:                     2048,
+		UnAckedMessages:                 10,
+		LastConsumedTimestamp:           now.Add(-2 * time.Minute).UnixMilli(),
+		EarliestMsgPublishTimeInBacklog: now.Add(-15 * time.Minute).UnixMilli(),
+		Consumers:                       make([]utils.ConsumerStats, 2),
+	}
+
+	l := newSubscriptionLag("t", "s", sub, now, lagThresholds{})
+	assert.False(t, l.Lagging)
+	assert.Equal(t, 2, l.Consumers)
+	assert.Equal(t, "15m0s", l.OldestBacklogAge)
+
+	l = newSubscriptionLag("t", "s", sub, now, lagThresholds{
+		backlog:     100,
+		backlogSize: 4096,
+		age:         10 * time.Minute,
+		idle:        time.Minute,
+	})
+	assert.True(t, l.Lagging)
+	assert.Equal(t, []string{"backlog > 100", "oldest age > 10m0s", "idle > 1m0s"}, l.Reasons)
+
+	// a subscription without a backlog is not idle
+	sub.MsgBacklog = 0
+	l = newSubscriptionLag("t", "s", sub, now, lagThresholds{idle: time.Minute})
+	assert.False(t, l.Lagging)
+	assert.Empty(t, l.OldestBacklogAge)
+}
+
+func TestLagArgsError(t *testing.T) {
+	_, _, nameErr, _ := TestSubCommands(LagCmd, []string{"lag"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the tenant or the namespace name is not specified or specified more than one", nameErr.Error())
+}
+
+func TestLagInvalidBacklogSize(t *testing.T) {
+	_, execErr, _, _ := TestSubCommands(LagCmd, []string{"lag", "public/default", "--max-backlog-size", "10X"})
+	assert.NotNil(t, execErr)
+	assert.Contains(t, execErr.Error(), "invalid backlog size '10X'")
+}
+
+func TestLag(t *testing.T) {
+	out, execErr, _, _ := TestSubCommands(LagCmd, []string{"lag", "public"})
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "SUBSCRIPTION")
+}
@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+// fetchMessages reads the entry returned by the given endpoint of the topic, a batched
+// entry is split into its individual messages
+func fetchMessages(rc *cmdutils.RestClient, topic utils.TopicName, parts ...string) ([]*message.Message, error) {
+	endpoint := rc.Endpoint("", append([]string{topic.GetRestPath()}, parts...)...)
+	resp, err := rc.MakeRequest(http.MethodGet, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return message.FromResponse(topic, resp)
+}
+
+// decodeMessages decodes the message payloads with the topic schema
+func decodeMessages(topic utils.TopicName, msgs []*message.Message) {
+	admin := cmdutils.NewPulsarClient()
+	decoder := message.NewDecoder(message.AdminSchemaFetcher(admin.Schemas(), topic.String()))
+	for _, m := range msgs {
+		decoder.Decode(m)
+	}
+}
+
+// writeMessages decodes the message payloads with the topic schema unless raw is set
+// and writes the messages in the configured output format
+func writeMessages(vc *cmdutils.VerbCmd, topic utils.TopicName, msgs []*message.Message, raw bool) error {
+	if !raw {
+		decodeMessages(topic, msgs)
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(msgs).
+		WithTextFunc(func(w io.Writer) error {
+			return message.WriteText(w, msgs)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
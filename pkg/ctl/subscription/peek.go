@@ -18,20 +18,20 @@
 package subscription
 
 import (
-	"encoding/hex"
-	"encoding/json"
-	"fmt"
+	"strconv"
 
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
 )
 
 func PeekCmd(vc *cmdutils.VerbCmd) {
 	var desc cmdutils.LongDescription
-	desc.CommandUsedFor = "This command is used for peeking some messages of a subscription."
+	desc.CommandUsedFor = "This command is used for peeking some messages of a subscription. " +
+		"The redelivery count is not stored with the message, so it is not shown."
 	desc.CommandPermission = "This command requires tenant admin permissions or namespace consumer permissions."
 
 	var example []cmdutils.Example
@@ -39,16 +39,30 @@ func PeekCmd(vc *cmdutils.VerbCmd) {
 		Desc:    "Peek some messages of a subscription",
 		Command: "pulsarctl subscriptions peek --count (n) (topic-name) (subscription-name)",
 	}
-	example = append(example, peek)
+	peekRaw := cmdutils.Example{
+		Desc:    "Peek some messages of a subscription without decoding the payload with the topic schema",
+		Command: "pulsarctl subscriptions peek --count (n) --raw (topic-name) (subscription-name)",
+	}
+	peekJSON := cmdutils.Example{
+		Desc:    "Peek some messages of a subscription and print them in JSON",
+		Command: "pulsarctl subscriptions peek --count (n) -o json (topic-name) (subscription-name)",
+	}
+	example = append(example, peek, peekRaw, peekJSON)
 	desc.CommandExamples = example
 
 	var out []cmdutils.Output
 	success := cmdutils.Output{
 		Desc: "normal output",
-		Out: `Message ID :
-ledgerID:entryID:PartitionIndex:BatchIndex
-Properties :
-Message :`,
+		Out: `Message ID       : ledgerID:entryID:PartitionIndex:BatchIndex
+Batch Index      : BatchIndex/BatchSize
+Publish Time     : 2020-01-01T00:00:00Z
+Key              : (key)
+Producer         : (producer-name)
+Sequence ID      : 0
+Schema Version   : 0
+Properties       : {}
+Message :
+(decoded payload)`,
 	}
 	out = append(out, success, ArgsError)
 	out = append(out, TopicNameErrors...)
@@ -61,18 +75,24 @@ Message :`,
 		desc.ExampleToString())
 
 	var count int
+	var raw bool
 
 	vc.SetRunFuncWithMultiNameArgs(func() error {
-		return doPeek(vc, count)
+		return doPeek(vc, count, raw)
 	}, CheckSubscriptionNameTwoArgs)
 
 	vc.FlagSetGroup.InFlagSet("Peek", func(set *pflag.FlagSet) {
 		set.IntVarP(&count, "count", "n", 1, "Number of messages (default 1)")
+		set.BoolVar(&raw, "raw", false, "Print the payload as a hex dump instead of decoding it with the topic schema")
 	})
 	vc.EnableOutputFlagSet()
 }
 
-func doPeek(vc *cmdutils.VerbCmd, n int) error {
+func doPeek(vc *cmdutils.VerbCmd, n int, raw bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
 
 	topic, err := utils.GetTopicName(vc.NameArgs[0])
 	if err != nil {
@@ -85,28 +105,18 @@ func doPeek(vc *cmdutils.VerbCmd, n int) error {
 
 	sName := vc.NameArgs[1]
 
-	admin := cmdutils.NewPulsarClient()
-	msgs, err := admin.Subscriptions().PeekMessages(*topic, sName, n)
-	if err == nil {
-		pos := 0
-		out := ""
-
-		for _, v := range msgs {
-			m := *v
-			pos++
-			if pos != 1 {
-				out += fmt.Sprintln("-------------------------------------------------------------------------")
-			}
-			out += fmt.Sprintln("Message ID : " + m.GetMessageID().String())
-			out += fmt.Sprintln("Properties : ")
-			p, _ := json.MarshalIndent(m.GetProperties(), "", "    ")
-			out += fmt.Sprintln(string(p))
-			out += fmt.Sprintln("Message :")
-			out += fmt.Sprintln(hex.Dump(m.GetPayload()))
+	rc := cmdutils.NewPulsarRestClient()
+	var msgs []*message.Message
+	for pos := 1; len(msgs) < n; pos++ {
+		entry, err := fetchMessages(rc, *topic, "subscription", sName, "position", strconv.Itoa(pos))
+		if err != nil {
+			return err
 		}
-
-		vc.Command.Println(out)
+		msgs = append(msgs, entry...)
+	}
+	if len(msgs) > n {
+		msgs = msgs[:n]
 	}
 
-	return err
+	return writeMessages(vc, *topic, msgs, raw)
 }
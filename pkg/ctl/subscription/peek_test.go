@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+func TestPeekArgsError(t *testing.T) {
+	args := []string{"peek", "test-peek-args-topic"}
+	_, _, nameErr, _ := TestSubCommands(PeekCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "need to specified the topic name and the subscription name", nameErr.Error())
+}
+
+func TestPeekNonPersistentTopic(t *testing.T) {
+	args := []string{"peek", "non-persistent://public/default/test-peek-topic", "test-peek-sub"}
+	_, execErr, _, _ := TestSubCommands(PeekCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the specified topic name is not a persistent topic", execErr.Error())
+}
+
+func TestMessageByIDJSON(t *testing.T) {
+	m, err := newMessageByID(&message.Message{
+		MessageID:  "1:2:-1:3",
+		BatchIndex: 3,
+		BatchSize:  5,
+		Properties: map[string]string{"a": "b"},
+		Payload:    []byte("hello"),
+	})
+	assert.Nil(t, err)
+	b, err := json.Marshal(m)
+	assert.Nil(t, err)
+
+	var out map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &out))
+	assert.Equal(t, map[string]interface{}{"ledgerId": 1.0, "entryId": 2.0, "partitionIndex": -1.0},
+		out["messageId"])
+	assert.Equal(t, 3.0, out["batchIndex"])
+	assert.Equal(t, "hello", out["PayloadString"])
+	assert.Equal(t, "aGVsbG8=", out["payload"])
+	assert.Equal(t, map[string]interface{}{"a": "b"}, out["properties"])
+	assert.NotContains(t, out, "redeliveryCount")
+}
@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionPropertiesCmd(t *testing.T) {
+	args := []string{"create", "--property", "team=payments", "--property", "env=prod",
+		"test-sub-properties-topic", "test-sub-properties-sub"}
+	_, execErr, _, _ := TestSubCommands(CreateCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"get-properties", "test-sub-properties-topic", "test-sub-properties-sub"}
+	out, execErr, _, _ := TestSubCommands(GetPropertiesCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "{\n  \"env\": \"prod\",\n  \"team\": \"payments\"\n}\n", out.String())
+
+	args = []string{"update-properties", "--property", "team=billing", "--remove", "env",
+		"test-sub-properties-topic", "test-sub-properties-sub"}
+	out, execErr, _, _ = TestSubCommands(UpdatePropertiesCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Update the properties of the subscription test-sub-properties-sub of the topic "+
+		"persistent://public/default/test-sub-properties-topic successfully\n", out.String())
+
+	args = []string{"get-properties", "test-sub-properties-topic", "test-sub-properties-sub"}
+	out, execErr, _, _ = TestSubCommands(GetPropertiesCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "{\n  \"team\": \"billing\"\n}\n", out.String())
+}
+
+func TestUpdatePropertiesFlagError(t *testing.T) {
+	args := []string{"update-properties", "test-sub-properties-topic", "test-sub-properties-sub"}
+	_, execErr, _, _ := TestSubCommands(UpdatePropertiesCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "at least one property to update or remove should be specified", execErr.Error())
+}
+
+func TestMergeProperties(t *testing.T) {
+	existing := map[string]string{"team": "payments", "env": "prod"}
+	merged := mergeProperties(existing, map[string]string{"team": "billing", "tier": "gold"}, []string{"env"})
+	assert.Equal(t, map[string]string{"team": "billing", "tier": "gold"}, merged)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, existing)
+}
@@ -18,20 +18,26 @@
 package subscription
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
 )
 
 func ResetCursorCmd(vc *cmdutils.VerbCmd) {
 	var desc cmdutils.LongDescription
 	desc.CommandUsedFor = "This command is used for resetting the position of a " +
-		"subscription to a position that is closest to the provided timestamp or messageId."
+		"subscription to a position that is closest to the provided timestamp or messageId, " +
+		"or to the position of another subscription. The cursor is reset on all the partitions " +
+		"of a partitioned topic concurrently, and the resulting position and backlog change " +
+		"of each partition are reported."
 	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
 
 	var examples []cmdutils.Example
@@ -41,26 +47,68 @@ func ResetCursorCmd(vc *cmdutils.VerbCmd) {
 		Command: "pulsarctl seek --time (time) (topic-name) (subscription-name)",
 	}
 
+	resetCursorRelativeTime := cmdutils.Example{
+		Desc:    "Reset the position of the subscription (subscription-name) to one hour ago",
+		Command: "pulsarctl seek --time -1h (topic-name) (subscription-name)",
+	}
+
+	resetCursorRFC3339 := cmdutils.Example{
+		Desc:    "Reset the position of the subscription (subscription-name) to an RFC3339 timestamp",
+		Command: "pulsarctl seek --time 2024-01-02T15:04:05Z (topic-name) (subscription-name)",
+	}
+
 	resetCursorMessageID := cmdutils.Example{
 		Desc: "Reset the position of the subscription <subscription-name> to a " +
 			"position that is closest to the provided message id (message-id)",
 		Command: "pulsarctl seek --message-id (message-id) (topic-name) (subscription-name)",
 	}
-	examples = append(examples, resetCursorTime, resetCursorMessageID)
+
+	resetCursorEarliest := cmdutils.Example{
+		Desc:    "Reset the position of the subscription (subscription-name) to the earliest position",
+		Command: "pulsarctl seek --message-id earliest (topic-name) (subscription-name)",
+	}
+
+	resetCursorSameAs := cmdutils.Example{
+		Desc: "Reset the position of the subscription (subscription-name) to the mark-delete position " +
+			"of the subscription (other-subscription-name)",
+		Command: "pulsarctl seek --same-as (other-subscription-name) (topic-name) (subscription-name)",
+	}
+	examples = append(examples, resetCursorTime, resetCursorRelativeTime, resetCursorRFC3339,
+		resetCursorMessageID, resetCursorEarliest, resetCursorSameAs)
 	desc.CommandExamples = examples
 
 	var out []cmdutils.Output
 	successOut := cmdutils.Output{
 		Desc: "normal output",
-		Out:  "Reset the cursor of the subscription (subscription-name) to (time)/(message-id) successfully",
+		Out: "+-------------------------------------------+--------------+----------------------+---------------+\n" +
+			"|                   TOPIC                   | SUBSCRIPTION | MARK DELETE POSITION | READ POSITION |\n" +
+			"+-------------------------------------------+--------------+----------------------+---------------+\n" +
+			"| persistent://public/default/t-partition-0 | sub          | 14:9                 | 14:10         |\n" +
+			"| persistent://public/default/t-partition-1 | sub          | 15:4                 | 15:5          |\n" +
+			"+-------------------------------------------+--------------+----------------------+---------------+\n" +
+			"(continued)\n" +
+			"+----------------+---------------+-------+\n" +
+			"| BACKLOG BEFORE | BACKLOG AFTER | DELTA |\n" +
+			"+----------------+---------------+-------+\n" +
+			"| 0              | 10            | +10   |\n" +
+			"| 0              | 5             | +5    |\n" +
+			"+----------------+---------------+-------+\n" +
+			"Reset the cursor of the subscription (subscription-name) to (position) successfully",
 	}
 
 	resetFlagError := cmdutils.Output{
-		Desc: "the time is not specified or the message id is not specified",
-		Out:  "[✖]  The reset position must be specified",
+		Desc: "none or more than one of the time, the message id and the subscription are specified",
+		Out:  "[✖]  exactly one of the time, message-id or same-as should be specified",
+	}
+
+	partitionIndexError := cmdutils.Output{
+		Desc: "the message id of a partitioned topic does not contain the partition index",
+		Out: "[✖]  the message id of a partitioned topic should be in the form of " +
+			"ledgerId:entryId:partitionIndex",
 	}
 
-	out = append(out, successOut, ArgsError, resetFlagError, TopicNotFoundError, SubNotFoundError)
+	out = append(out, successOut, ArgsError, resetFlagError, partitionIndexError, TopicNotFoundError,
+		SubNotFoundError)
 	out = append(out, TopicNameErrors...)
 	out = append(out, NamespaceErrors...)
 	desc.CommandOutput = out
@@ -74,28 +122,38 @@ func ResetCursorCmd(vc *cmdutils.VerbCmd) {
 
 	var t string
 	var mID string
+	var sameAs string
 
 	vc.SetRunFuncWithMultiNameArgs(func() error {
-		return doResetCursor(vc, t, mID)
+		return doResetCursor(vc, t, mID, sameAs)
 	}, CheckSubscriptionNameTwoArgs)
 
 	vc.FlagSetGroup.InFlagSet("ResetCursor", func(set *pflag.FlagSet) {
 		set.StringVarP(&t, "time", "t", "",
-			"time to reset back to (e.g. 1s, 1m, 1h)")
+			"time to reset back to, either a relative time (e.g. 1m, -1h, 2d), "+
+				"an RFC3339 timestamp or a unix timestamp in milliseconds")
 		set.StringVarP(&mID, "message-id", "m", "",
-			"message id to reset back to (e.g. ledgerId:entryId)")
+			"message id to reset back to (e.g. ledgerId:entryId[:partitionIndex]), earliest or latest")
+		set.StringVar(&sameAs, "same-as", "",
+			"reset to the mark-delete position of another subscription of the topic")
 	})
 	vc.EnableOutputFlagSet()
 }
 
-func doResetCursor(vc *cmdutils.VerbCmd, t, mID string) error {
+func doResetCursor(vc *cmdutils.VerbCmd, t, mID, sameAs string) error {
 	// for testing
 	if vc.NameError != nil {
 		return vc.NameError
 	}
 
-	if t != "" && mID != "" {
-		return errors.New("the time and message-id can not specified at the same time")
+	specified := 0
+	for _, v := range []string{t, mID, sameAs} {
+		if v != "" {
+			specified++
+		}
+	}
+	if specified != 1 {
+		return errors.New("exactly one of the time, message-id or same-as should be specified")
 	}
 
 	topic, err := utils.GetTopicName(vc.NameArgs[0])
@@ -109,34 +167,107 @@ func doResetCursor(vc *cmdutils.VerbCmd, t, mID string) error {
 		return errors.New("the specified topic name is not a persistent topic")
 	}
 
-	admin := cmdutils.NewPulsarClient()
+	client := cmdutils.NewPulsarClient()
+	partitions, err := cmdutils.TopicPartitions(client.Topics(), *topic)
+	if err != nil {
+		return err
+	}
+
+	var move func(partition utils.TopicName) error
+	var position string
 	switch {
 	case t != "":
-		d, err := time.ParseDuration(t)
+		resetTime, err := ctlutils.ParseTime(t, time.Now())
 		if err != nil {
 			return err
 		}
-		resetTime := time.Now().Add(-d).UnixNano() / 1e6
-		err = admin.Subscriptions().ResetCursorToTimestamp(*topic, sName, resetTime)
-		if err == nil {
-			vc.Command.Printf("Reset the cursor of the subscription %s to %s successfully\n", sName, t)
+		position = t
+		move = func(partition utils.TopicName) error {
+			return client.Subscriptions().ResetCursorToTimestamp(partition, sName, resetTime.UnixMilli())
 		}
-
-		return err
 	case mID != "":
-		if len(strings.Split(mID, ":")) != 2 {
-			return errors.Errorf("invalid position value : %s", mID)
-		}
-		id, err := utils.ParseMessageID(mID)
+		id, err := parseResetMessageID(mID)
 		if err != nil {
 			return err
 		}
-		err = admin.Subscriptions().ResetCursorToMessageID(*topic, sName, *id)
-		if err == nil {
-			vc.Command.Printf("Reset the cursor of the subscription %s to %s successfully\n", sName, mID)
+		if partitions, err = messageIDPartitions(topic, partitions, id); err != nil {
+			return err
+		}
+		position = mID
+		move = func(partition utils.TopicName) error {
+			return client.Subscriptions().ResetCursorToMessageID(partition, sName, partitionMessageID(id, partition))
 		}
-		return err
 	default:
-		return errors.New("either timestamp or message-id should be specified")
+		position = fmt.Sprintf("the position of the subscription %s", sameAs)
+		move = resetToSubscription(client, sName, sameAs)
+	}
+
+	changes := moveCursors(client.Topics(), partitions, sName, move)
+	return writeCursorChanges(vc, changes,
+		fmt.Sprintf("Reset the cursor of the subscription %s to %s successfully", sName, position))
+}
+
+// parseResetMessageID parses earliest, latest or a message id in the form of
+// ledgerId:entryId[:partitionIndex[:batchIndex]]
+func parseResetMessageID(s string) (utils.MessageID, error) {
+	switch strings.ToLower(s) {
+	case ctlutils.Earliest:
+		return utils.Earliest, nil
+	case ctlutils.Latest:
+		return utils.Latest, nil
+	}
+	id, err := utils.ParseMessageID(s)
+	if err != nil {
+		return utils.MessageID{}, errors.Errorf("invalid position value : %s", s)
+	}
+	return *id, nil
+}
+
+// messageIDPartitions returns the partitions the cursor should be reset on for the message id. A
+// message id which is neither earliest nor latest belongs to a single partition of a partitioned
+// topic, so it must contain the partition index
+func messageIDPartitions(topic *utils.TopicName, partitions []utils.TopicName,
+	id utils.MessageID) ([]utils.TopicName, error) {
+	if id == utils.Earliest || id == utils.Latest || topic.GetPartitionIndex() >= 0 ||
+		partitions[0].GetPartitionIndex() < 0 {
+		return partitions, nil
+	}
+	if id.PartitionIndex < 0 {
+		return nil, errors.New("the message id of a partitioned topic should be in the form of " +
+			"ledgerId:entryId:partitionIndex")
+	}
+	if id.PartitionIndex >= len(partitions) {
+		return nil, errors.Errorf("the partition index %d is out of range, the topic %s has %d partitions",
+			id.PartitionIndex, topic.String(), len(partitions))
+	}
+	return partitions[id.PartitionIndex : id.PartitionIndex+1], nil
+}
+
+// partitionMessageID returns the message id with the partition index of the partition
+func partitionMessageID(id utils.MessageID, partition utils.TopicName) utils.MessageID {
+	id.PartitionIndex = partition.GetPartitionIndex()
+	return id
+}
+
+// resetToSubscription resets the cursor of the subscription right after the mark-delete position
+// of the other subscription, so both subscriptions have the same backlog
+func resetToSubscription(client admin.Client, sub, other string) func(partition utils.TopicName) error {
+	rc := cmdutils.NewPulsarRestClient()
+	return func(partition utils.TopicName) error {
+		stats, err := client.Topics().GetInternalStats(partition)
+		if err != nil {
+			return err
+		}
+		cursor, ok := stats.Cursors[other]
+		if !ok {
+			return errors.Errorf("the subscription %s does not exist on the topic %s", other, partition.String())
+		}
+		id, err := utils.ParseMessageID(cursor.MarkDeletePosition)
+		if err != nil {
+			return err
+		}
+		data := newResetCursorData(partitionMessageID(*id, partition))
+		data.IsExcluded = true
+		return rc.Post(rc.Endpoint("", partition.GetRestPath(), "subscription", sub, "resetcursor"), data)
 	}
 }
@@ -32,14 +32,28 @@ func TestResetCursorCmd(t *testing.T) {
 	args = []string{"seek", "--time", "1m", "test-reset-cursor-topic", "test-reset-cursor-sub"}
 	out, execErr, _, _ := TestSubCommands(ResetCursorCmd, args)
 	assert.Nil(t, execErr)
-	assert.Equal(t, "Reset the cursor of the subscription test-reset-cursor-sub to 1m successfully\n",
-		out.String())
+	assert.Contains(t, out.String(), "Reset the cursor of the subscription test-reset-cursor-sub to 1m successfully\n")
 
 	args = []string{"seek", "--message-id", "-1:-1", "test-reset-cursor-topic", "test-reset-cursor-sub"}
 	out, execErr, _, _ = TestSubCommands(ResetCursorCmd, args)
 	assert.Nil(t, execErr)
-	assert.Equal(t, "Reset the cursor of the subscription test-reset-cursor-sub to -1:-1 successfully\n",
-		out.String())
+	assert.Contains(t, out.String(), "Reset the cursor of the subscription test-reset-cursor-sub to -1:-1 successfully\n")
+
+	args = []string{"seek", "--message-id", "earliest", "test-reset-cursor-topic", "test-reset-cursor-sub"}
+	out, execErr, _, _ = TestSubCommands(ResetCursorCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "persistent://public/default/test-reset-cursor-topic")
+
+	args = []string{"create", "test-reset-cursor-topic", "test-reset-cursor-other-sub"}
+	_, execErr, _, _ = TestSubCommands(CreateCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"seek", "--same-as", "test-reset-cursor-other-sub", "test-reset-cursor-topic",
+		"test-reset-cursor-sub"}
+	out, execErr, _, _ = TestSubCommands(ResetCursorCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "Reset the cursor of the subscription test-reset-cursor-sub to "+
+		"the position of the subscription test-reset-cursor-other-sub successfully\n")
 }
 
 func TestResetCursorArgsError(t *testing.T) {
@@ -53,7 +67,12 @@ func TestResetCursorFlagError(t *testing.T) {
 	args := []string{"seek", "test-reset-cursor-flag-topic", "flag-sub"}
 	_, execErr, _, _ := TestSubCommands(ResetCursorCmd, args)
 	assert.NotNil(t, execErr)
-	assert.Equal(t, "either timestamp or message-id should be specified", execErr.Error())
+	assert.Equal(t, "exactly one of the time, message-id or same-as should be specified", execErr.Error())
+
+	args = []string{"seek", "--time", "1m", "--same-as", "other-sub", "test-reset-cursor-flag-topic", "flag-sub"}
+	_, execErr, _, _ = TestSubCommands(ResetCursorCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "exactly one of the time, message-id or same-as should be specified", execErr.Error())
 }
 
 func TestResetCursorNonExistingTopic(t *testing.T) {
@@ -39,9 +39,15 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 		SkipCmd,
 		PeekCmd,
 		GetMessageByIDCmd,
+		LagCmd,
+		CloneCmd,
+		GetPropertiesCmd,
+		UpdatePropertiesCmd,
+		AnalyzeBacklogCmd,
 	}
 
 	cmdutils.AddVerbCmds(flagGrouping, resourceCmd, command...)
+	resourceCmd.AddCommand(dlqCommand(flagGrouping))
 
 	return resourceCmd
 }
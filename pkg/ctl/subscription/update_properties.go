@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package subscription
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func UpdatePropertiesCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for updating the properties of a subscription, the given " +
+		"properties are added to or overwrite the existing properties, and the removed keys are deleted."
+	desc.CommandPermission = "This command requires tenant admin and namespace produce or consume permissions."
+
+	var examples []cmdutils.Example
+	update := cmdutils.Example{
+		Desc:    "Label the subscription (subscription-name) with the owning team",
+		Command: "pulsarctl subscriptions update-properties --property team=payments (topic-name) (subscription-name)",
+	}
+
+	removeProperty := cmdutils.Example{
+		Desc:    "Remove the property env from the subscription (subscription-name)",
+		Command: "pulsarctl subscriptions update-properties --remove env (topic-name) (subscription-name)",
+	}
+	examples = append(examples, update, removeProperty)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Update the properties of the subscription (subscription-name) of the topic (topic-name) successfully",
+	}
+
+	flagError := cmdutils.Output{
+		Desc: "neither the properties to update nor the keys to remove are specified",
+		Out:  "[✖]  at least one property to update or remove should be specified",
+	}
+	out = append(out, successOut, ArgsError, flagError, TopicNotFoundError, SubNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"update-properties",
+		"Update the properties of a subscription",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"update-properties")
+
+	var properties map[string]string
+	var remove []string
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doUpdateProperties(vc, properties, remove)
+	}, CheckSubscriptionNameTwoArgs)
+
+	vc.FlagSetGroup.InFlagSet("Properties", func(set *pflag.FlagSet) {
+		set.StringToStringVarP(&properties, "property", "p", nil,
+			"Properties of the subscription (key=value), can be specified multiple times")
+		set.StringSliceVarP(&remove, "remove", "r", nil,
+			"Keys of the properties to remove, can be specified multiple times")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doUpdateProperties(vc *cmdutils.VerbCmd, properties map[string]string, remove []string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if len(properties) == 0 && len(remove) == 0 {
+		return errors.New("at least one property to update or remove should be specified")
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArgs[0])
+	if err != nil {
+		return err
+	}
+	sName := vc.NameArgs[1]
+
+	// the broker replaces all the properties of the subscription
+	rc := cmdutils.NewPulsarRestClient()
+	existing, err := getSubscriptionProperties(rc, topic, sName)
+	if err != nil {
+		return err
+	}
+	err = rc.Put(rc.Endpoint("", topic.GetRestPath(), "subscription", sName, "properties"),
+		mergeProperties(existing, properties, remove))
+	if err == nil {
+		vc.Command.Printf("Update the properties of the subscription %s of the topic %s successfully\n",
+			sName, topic.String())
+	}
+	return err
+}
+
+func mergeProperties(existing, properties map[string]string, remove []string) map[string]string {
+	merged := make(map[string]string, len(existing)+len(properties))
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range properties {
+		merged[k] = v
+	}
+	for _, k := range remove {
+		delete(merged, k)
+	}
+	return merged
+}
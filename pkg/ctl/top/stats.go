@@ -0,0 +1,192 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+)
+
+const partitionSuffix = "-partition-"
+
+// TopicStats is the aggregated stats of a topic, the stats of a partitioned topic are
+// aggregated over its partitions
+type TopicStats struct {
+	Topic            string  `json:"topic"`
+	Partitions       int     `json:"partitions"`
+	MsgRateIn        float64 `json:"msgRateIn"`
+	MsgRateOut       float64 `json:"msgRateOut"`
+	MsgThroughputIn  float64 `json:"msgThroughputIn"`
+	MsgThroughputOut float64 `json:"msgThroughputOut"`
+	StorageSize      int64   `json:"storageSize"`
+	Backlog          int64   `json:"backlog"`
+	Producers        int     `json:"producers"`
+	Consumers        int     `json:"consumers"`
+	Subscriptions    int     `json:"subscriptions"`
+}
+
+// sortKeys maps the values of --sort-by to the functions comparing two topics, the
+// topics are sorted in descending order except by name
+var sortKeys = map[string]func(a, b *TopicStats) bool{
+	"name":           func(a, b *TopicStats) bool { return a.Topic < b.Topic },
+	"rate-in":        func(a, b *TopicStats) bool { return a.MsgRateIn > b.MsgRateIn },
+	"rate-out":       func(a, b *TopicStats) bool { return a.MsgRateOut > b.MsgRateOut },
+	"throughput-in":  func(a, b *TopicStats) bool { return a.MsgThroughputIn > b.MsgThroughputIn },
+	"throughput-out": func(a, b *TopicStats) bool { return a.MsgThroughputOut > b.MsgThroughputOut },
+	"storage":        func(a, b *TopicStats) bool { return a.StorageSize > b.StorageSize },
+	"backlog":        func(a, b *TopicStats) bool { return a.Backlog > b.Backlog },
+	"producers":      func(a, b *TopicStats) bool { return a.Producers > b.Producers },
+	"consumers":      func(a, b *TopicStats) bool { return a.Consumers > b.Consumers },
+	"subscriptions":  func(a, b *TopicStats) bool { return a.Subscriptions > b.Subscriptions },
+}
+
+func sortKeyNames() []string {
+	names := make([]string, 0, len(sortKeys))
+	for k := range sortKeys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortStats sorts the topics by the key, ties are broken by the topic name
+func sortStats(stats []*TopicStats, key string) error {
+	less, ok := sortKeys[key]
+	if !ok {
+		return fmt.Errorf("invalid sort key '%s', it should be one of %s", key, strings.Join(sortKeyNames(), ", "))
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		if less(stats[i], stats[j]) {
+			return true
+		}
+		if less(stats[j], stats[i]) {
+			return false
+		}
+		return stats[i].Topic < stats[j].Topic
+	})
+	return nil
+}
+
+// namespaceTopics returns the partitioned topics and the non-partitioned topics of a
+// namespace, the partitions of the partitioned topics are left out
+func namespaceTopics(partitioned, nonPartitioned []string) []string {
+	isPartitioned := make(map[string]bool, len(partitioned))
+	topics := make([]string, 0, len(partitioned)+len(nonPartitioned))
+	for _, t := range partitioned {
+		isPartitioned[t] = true
+		topics = append(topics, t)
+	}
+	for _, t := range nonPartitioned {
+		if i := strings.LastIndex(t, partitionSuffix); i > 0 && isPartitioned[t[:i]] {
+			continue
+		}
+		topics = append(topics, t)
+	}
+	return topics
+}
+
+// collectStats fetches the stats of the topics with a bounded number of concurrent
+// requests. The topics failing to fetch are reported with their errors.
+func collectStats(topics admin.Topics, names []string, partitioned map[string]bool,
+	concurrency int) ([]*TopicStats, map[string]error) {
+	stats := make([]*TopicStats, len(names))
+	errs := make([]error, len(names))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				stats[i], errs[i] = fetchStats(topics, names[i], partitioned[names[i]])
+			}
+		}()
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var result []*TopicStats
+	failures := make(map[string]error)
+	for i, s := range stats {
+		if errs[i] != nil {
+			failures[names[i]] = errs[i]
+			continue
+		}
+		result = append(result, s)
+	}
+	return result, failures
+}
+
+func fetchStats(topics admin.Topics, name string, partitioned bool) (*TopicStats, error) {
+	topic, err := utils.GetTopicName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if partitioned {
+		s, err := topics.GetPartitionedStats(*topic, false)
+		if err != nil {
+			return nil, err
+		}
+		ts := &TopicStats{
+			Topic:            name,
+			Partitions:       s.Metadata.Partitions,
+			MsgRateIn:        s.MsgRateIn,
+			MsgRateOut:       s.MsgRateOut,
+			MsgThroughputIn:  s.MsgThroughputIn,
+			MsgThroughputOut: s.MsgThroughputOut,
+			StorageSize:      s.StorageSize,
+			Producers:        len(s.Publishers),
+		}
+		addSubscriptions(ts, s.Subscriptions)
+		return ts, nil
+	}
+
+	s, err := topics.GetStats(*topic)
+	if err != nil {
+		return nil, err
+	}
+	ts := &TopicStats{
+		Topic:            name,
+		MsgRateIn:        s.MsgRateIn,
+		MsgRateOut:       s.MsgRateOut,
+		MsgThroughputIn:  s.MsgThroughputIn,
+		MsgThroughputOut: s.MsgThroughputOut,
+		StorageSize:      s.StorageSize,
+		Producers:        len(s.Publishers),
+	}
+	addSubscriptions(ts, s.Subscriptions)
+	return ts, nil
+}
+
+func addSubscriptions(ts *TopicStats, subs map[string]utils.SubscriptionStats) {
+	ts.Subscriptions = len(subs)
+	for _, sub := range subs {
+		ts.Backlog += sub.MsgBacklog
+		ts.Consumers += len(sub.Consumers)
+	}
+}
@@ -0,0 +1,81 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceTopics(t *testing.T) {
+	topics := namespaceTopics(
+		[]string{"persistent://public/default/p"},
+		[]string{
+			"persistent://public/default/p-partition-0",
+			"persistent://public/default/p-partition-1",
+			"persistent://public/default/np",
+			"persistent://public/default/orphan-partition-0",
+		})
+	assert.Equal(t, []string{
+		"persistent://public/default/p",
+		"persistent://public/default/np",
+		"persistent://public/default/orphan-partition-0",
+	}, topics)
+}
+
+func TestSortStats(t *testing.T) {
+	stats := []*TopicStats{
+		{Topic: "b", MsgRateIn: 10, Backlog: 5},
+		{Topic: "a", MsgRateIn: 10, Backlog: 1},
+		{Topic: "c", MsgRateIn: 20, Backlog: 3},
+	}
+
+	assert.Nil(t, sortStats(stats, "rate-in"))
+	assert.Equal(t, []string{"c", "a", "b"}, topicNames(stats))
+
+	assert.Nil(t, sortStats(stats, "backlog"))
+	assert.Equal(t, []string{"b", "c", "a"}, topicNames(stats))
+
+	assert.Nil(t, sortStats(stats, "name"))
+	assert.Equal(t, []string{"a", "b", "c"}, topicNames(stats))
+
+	err := sortStats(stats, "unknown")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid sort key 'unknown'")
+}
+
+func TestAddSubscriptions(t *testing.T) {
+	ts := &TopicStats{}
+	addSubscriptions(ts, map[string]utils.SubscriptionStats{
+		"s1": {MsgBacklog: 3, Consumers: make([]utils.ConsumerStats, 2)},
+		"s2": {MsgBacklog: 4},
+	})
+	assert.Equal(t, 2, ts.Subscriptions)
+	assert.Equal(t, int64(7), ts.Backlog)
+	assert.Equal(t, 2, ts.Consumers)
+}
+
+func topicNames(stats []*TopicStats) []string {
+	names := make([]string, 0, len(stats))
+	for _, s := range stats {
+		names = append(names, s.Topic)
+	}
+	return names
+}
@@ -0,0 +1,222 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// clearScreen moves the cursor home and clears the terminal before a refresh
+const clearScreen = "\033[H\033[2J"
+
+type topOptions struct {
+	sortBy      string
+	limit       int
+	concurrency int
+	watch       time.Duration
+}
+
+// Cmd builds the top command of the resource command, which is either topics or namespaces
+func Cmd(vc *cmdutils.VerbCmd, resource string) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the topics of a namespace ordered by their stats, " +
+		"such as the message rates, the throughput, the storage size or the backlog, to find the hot or the " +
+		"backlogged topics. The stats of the topics are fetched concurrently, and the stats of a partitioned " +
+		"topic are aggregated over its partitions. The backlog of a topic is the sum of the backlogs of " +
+		"its subscriptions."
+	desc.CommandPermission = "This command requires namespace admin permissions."
+
+	var examples []cmdutils.Example
+	top := cmdutils.Example{
+		Desc:    "List the 20 topics of a namespace (tenant/namespace) with the highest message rate in",
+		Command: fmt.Sprintf("pulsarctl %s top (tenant/namespace)", resource),
+	}
+	topBacklog := cmdutils.Example{
+		Desc:    "List the 5 most backlogged topics of a namespace (tenant/namespace)",
+		Command: fmt.Sprintf("pulsarctl %s top (tenant/namespace) --sort-by backlog --limit 5", resource),
+	}
+	topWatch := cmdutils.Example{
+		Desc:    "Refresh the topics of a namespace (tenant/namespace) every 5 seconds",
+		Command: fmt.Sprintf("pulsarctl %s top (tenant/namespace) --watch 5s", resource),
+	}
+	examples = append(examples, top, topBacklog, topWatch)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+----------------------------------+------------+---------+----------+---------------+----------------+---------+---------+-----------+-----------+---------------+
+|              TOPIC               | PARTITIONS | RATE IN | RATE OUT | THROUGHPUT IN | THROUGHPUT OUT | STORAGE | BACKLOG | PRODUCERS | CONSUMERS | SUBSCRIPTIONS |
++----------------------------------+------------+---------+----------+---------------+----------------+---------+---------+-----------+-----------+---------------+
+| persistent://public/default/test |          0 |  100.00 |   100.00 |     102400.00 |      102400.00 | 1048576 |       0 |         1 |         1 |             1 |
++----------------------------------+------------+---------+----------+---------------+----------------+---------+---------+-----------+-----------+---------------+`,
+	}
+	argError := cmdutils.Output{
+		Desc: "the namespace is not specified",
+		Out:  "[✖]  the namespace name is not specified or the namespace name is specified more than one",
+	}
+	sortError := cmdutils.Output{
+		Desc: "the sort key is invalid",
+		Out:  "[✖]  invalid sort key 'unknown', it should be one of " + strings.Join(sortKeyNames(), ", "),
+	}
+	out = append(out, successOut, argError, sortError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"top",
+		"List the topics of a namespace ordered by their stats",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"top")
+
+	opts := topOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doTop(vc, &opts)
+	}, "the namespace name is not specified or the namespace name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Top", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.sortBy, "sort-by", "rate-in",
+			"The stats to sort the topics by: "+strings.Join(sortKeyNames(), ", "))
+		set.IntVar(&opts.limit, "limit", 20, "The number of topics to list, 0 means all")
+		set.IntVar(&opts.concurrency, "concurrency", 8, "The number of the stats fetched concurrently")
+		set.DurationVarP(&opts.watch, "watch", "w", 0,
+			"Refresh the list on every interval until interrupted, 0 disables the refresh")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doTop(vc *cmdutils.VerbCmd, opts *topOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if _, ok := sortKeys[opts.sortBy]; !ok {
+		return sortStats(nil, opts.sortBy)
+	}
+	if opts.concurrency <= 0 {
+		return errors.New("the concurrency must be greater than 0")
+	}
+
+	ns, err := utils.GetNamespaceName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if opts.watch <= 0 {
+		return writeTop(vc, *ns, opts)
+	}
+
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupted)
+	ticker := time.NewTicker(opts.watch)
+	defer ticker.Stop()
+	// the screen is only cleared for the text output, so the JSON and YAML documents are not corrupted
+	clearScreenOnRefresh := cmdutils.OutputFormat(vc.OutputConfig.Format) == cmdutils.TextOutputFormat
+	for {
+		if clearScreenOnRefresh {
+			vc.Command.Print(clearScreen)
+		}
+		if err := writeTop(vc, *ns, opts); err != nil {
+			return err
+		}
+		select {
+		case <-interrupted:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeTop(vc *cmdutils.VerbCmd, ns utils.NameSpaceName, opts *topOptions) error {
+	admin := cmdutils.NewPulsarClient()
+	partitioned, nonPartitioned, err := admin.Topics().List(ns)
+	if err != nil {
+		return err
+	}
+
+	isPartitioned := make(map[string]bool, len(partitioned))
+	for _, t := range partitioned {
+		isPartitioned[t] = true
+	}
+	names := namespaceTopics(partitioned, nonPartitioned)
+
+	stats, failures := collectStats(admin.Topics(), names, isPartitioned, opts.concurrency)
+	failed := make([]string, 0, len(failures))
+	for t := range failures {
+		failed = append(failed, t)
+	}
+	sort.Strings(failed)
+	for _, t := range failed {
+		vc.Command.PrintErrf("Failed to get the stats of the topic %s: %v\n", t, failures[t])
+	}
+
+	if err := sortStats(stats, opts.sortBy); err != nil {
+		return err
+	}
+	if opts.limit > 0 && len(stats) > opts.limit {
+		stats = stats[:opts.limit]
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(stats).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Partitions", "Rate In", "Rate Out", "Throughput In",
+				"Throughput Out", "Storage", "Backlog", "Producers", "Consumers", "Subscriptions"})
+			for _, s := range stats {
+				table.Append([]string{
+					s.Topic,
+					strconv.Itoa(s.Partitions),
+					formatFloat(s.MsgRateIn),
+					formatFloat(s.MsgRateOut),
+					formatFloat(s.MsgThroughputIn),
+					formatFloat(s.MsgThroughputOut),
+					strconv.FormatInt(s.StorageSize, 10),
+					strconv.FormatInt(s.Backlog, 10),
+					strconv.Itoa(s.Producers),
+					strconv.Itoa(s.Consumers),
+					strconv.Itoa(s.Subscriptions),
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
@@ -0,0 +1,67 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/onsi/gomega"
+)
+
+func TestAutoSubscriptionCreation(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-auto-subscription-creation-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	getArgs := []string{"get-auto-subscription-creation", topicName}
+	getOut, execErr, _, _ := TestTopicCommands(GetAutoSubscriptionCreationCmd, getArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(getOut.String()).Should(gomega.Equal("null"))
+
+	setArgs := []string{"set-auto-subscription-creation", topicName, "--enable"}
+	setOut, execErr, _, _ := TestTopicCommands(SetAutoSubscriptionCreationCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set auto subscription creation override successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetAutoSubscriptionCreationCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var override utils.AutoSubscriptionCreationOverride
+		err := json.Unmarshal(getOut.Bytes(), &override)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(override.AllowAutoSubscriptionCreation).Should(gomega.BeTrue())
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-auto-subscription-creation", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveAutoSubscriptionCreationCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove auto subscription creation override successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetAutoSubscriptionCreationCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("null"))
+	}).Should(gomega.Succeed())
+}
@@ -0,0 +1,356 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// topicSelector selects the topics a command runs on by a pattern of the topic names
+// instead of a single topic name
+type topicSelector struct {
+	pattern       string
+	regex         bool
+	allNamespaces bool
+	yes           bool
+	concurrency   int
+}
+
+// BulkResult is the result of running a command on one of the topics matched by a pattern
+type BulkResult struct {
+	Topic string `json:"topic"`
+	Error string `json:"error,omitempty"`
+}
+
+func (s *topicSelector) addFlags(set *pflag.FlagSet) {
+	set.StringVar(&s.pattern, "pattern", "",
+		"Run on the topics whose names match the glob pattern ([domain://][tenant/namespace/]topic-pattern), "+
+			"the namespace defaults to public/default")
+	set.BoolVar(&s.regex, "regex", false,
+		"Treat the topic pattern as a regular expression instead of a glob")
+	set.BoolVar(&s.allNamespaces, "all-namespaces", false,
+		"Match the topic pattern, which can not contain a namespace, against the topics of all "+
+			"the namespaces of all the tenants")
+	set.BoolVar(&s.yes, "yes", false,
+		"Run on the matched topics without prompting for confirmation")
+	set.IntVar(&s.concurrency, "concurrency", 10,
+		"Number of topics to run on concurrently")
+}
+
+// setRunFuncWithTopicSelector registers a command function which runs on the topic specified
+// by the name argument, or on each of the topics matched by the topic selector flags
+func setRunFuncWithTopicSelector(vc *cmdutils.VerbCmd, cmd func(vc *cmdutils.VerbCmd) error, errMsg string) {
+	setRunFuncWithTopicSelectorArgs(vc, cmd, 0, func(args []string) error {
+		if len(args) != 1 {
+			return errors.New(errMsg)
+		}
+		return nil
+	})
+}
+
+// setRunFuncWithTopicSelectorArgs is setRunFuncWithTopicSelector for the commands which take
+// extra name arguments after the topic name, the extra arguments are passed to each of the
+// topics matched by the topic selector flags
+func setRunFuncWithTopicSelectorArgs(vc *cmdutils.VerbCmd, cmd func(vc *cmdutils.VerbCmd) error,
+	extraArgs int, checkArgs func(args []string) error) {
+	s := &topicSelector{}
+	vc.FlagSetGroup.InFlagSet("Topic Selector", s.addFlags)
+
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		if s.pattern == "" {
+			if vc.NameError == nil {
+				vc.NameArg = strings.TrimSpace(vc.NameArgs[0])
+			}
+			return cmd(vc)
+		}
+		// for testing
+		if vc.NameError != nil {
+			return vc.NameError
+		}
+		return s.run(vc, cmd)
+	}, func(args []string) error {
+		if s.pattern == "" {
+			return checkArgs(args)
+		}
+		if len(args) != extraArgs {
+			if extraArgs == 0 {
+				return errors.New("the topic name can not be specified with the --pattern flag")
+			}
+			return errors.Errorf("only %d arguments are allowed to be used with the --pattern flag", extraArgs)
+		}
+		return nil
+	})
+}
+
+func (s *topicSelector) run(vc *cmdutils.VerbCmd, cmd func(vc *cmdutils.VerbCmd) error) error {
+	if s.concurrency <= 0 {
+		return errors.New("the concurrency must be greater than 0")
+	}
+	p, err := parseTopicPattern(s.pattern, s.allNamespaces)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	topics, err := s.selectTopics(admin, p)
+	if err != nil {
+		return err
+	}
+	if len(topics) == 0 {
+		return errors.Errorf("no topics match the pattern %s", s.pattern)
+	}
+
+	vc.Command.Printf("The following %d topics match the pattern %s:\n", len(topics), s.pattern)
+	for _, t := range topics {
+		vc.Command.Println("  " + t)
+	}
+	if !s.yes {
+		ok, err := confirm(vc.Command, fmt.Sprintf("Are you sure you want to %s these %d topics?",
+			vc.Command.Name(), len(topics)))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			vc.Command.Println("Cancelled")
+			return nil
+		}
+	}
+
+	// the commands without the output flag write the results in text
+	oc := vc.OutputConfig
+	if oc == nil {
+		oc = &cmdutils.OutputConfig{Format: string(cmdutils.TextOutputFormat)}
+	}
+	results := runOnTopics(vc, topics, s.concurrency, cmd, oc)
+	return writeBulkResults(vc, oc, results)
+}
+
+// selectTopics lists the topics matched by the pattern, a partitioned topic is matched as
+// a whole instead of by its partitions
+func (s *topicSelector) selectTopics(admin admin.Client, p *topicPattern) ([]string, error) {
+	namespaces := []string{p.namespace}
+	if s.allNamespaces {
+		namespaces = nil
+		tenants, err := admin.Tenants().List()
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tenants {
+			ns, err := admin.Namespaces().GetNamespaces(t)
+			if err != nil {
+				return nil, err
+			}
+			namespaces = append(namespaces, ns...)
+		}
+	}
+
+	match, err := newTopicMatcher(p.topic, s.regex)
+	if err != nil {
+		return nil, err
+	}
+
+	var topics []string
+	for _, n := range namespaces {
+		ns, err := utils.GetNamespaceName(n)
+		if err != nil {
+			return nil, err
+		}
+		partitioned, nonPartitioned, err := admin.Topics().List(*ns)
+		if err != nil {
+			return nil, err
+		}
+		isPartitioned := make(map[string]bool, len(partitioned))
+		for _, t := range partitioned {
+			isPartitioned[t] = true
+		}
+		for _, t := range append(partitioned, nonPartitioned...) {
+			topic, err := utils.GetTopicName(t)
+			if err != nil {
+				return nil, err
+			}
+			suffix := fmt.Sprintf("%s%d", utils.PARTITIONEDTOPICSUFFIX, topic.GetPartitionIndex())
+			if topic.GetPartitionIndex() >= 0 && isPartitioned[strings.TrimSuffix(t, suffix)] {
+				continue
+			}
+			if p.domain != "" && topic.GetDomain().String() != p.domain {
+				continue
+			}
+			if match(topic.GetLocalName()) {
+				topics = append(topics, t)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// topicPattern is a topic pattern split into the optional domain, the namespace and the
+// pattern of the local topic names
+type topicPattern struct {
+	domain    string
+	namespace string
+	topic     string
+}
+
+// parseTopicPattern parses a pattern in the form of [domain://][tenant/namespace/]topic-pattern,
+// the namespace defaults to public/default and can not be specified with allNamespaces
+func parseTopicPattern(pattern string, allNamespaces bool) (*topicPattern, error) {
+	p := &topicPattern{namespace: "public/default", topic: pattern}
+	if i := strings.Index(p.topic, "://"); i >= 0 {
+		domain, err := utils.ParseTopicDomain(p.topic[:i])
+		if err != nil {
+			return nil, err
+		}
+		p.domain, p.topic = domain.String(), p.topic[i+len("://"):]
+	}
+	if i := strings.LastIndex(p.topic, "/"); i >= 0 {
+		if allNamespaces {
+			return nil, errors.New("the topic pattern can not contain a namespace when --all-namespaces is specified")
+		}
+		p.namespace, p.topic = p.topic[:i], p.topic[i+1:]
+	}
+	return p, nil
+}
+
+// newTopicMatcher returns a function which reports whether a local topic name matches the
+// whole glob or regular expression pattern
+func newTopicMatcher(pattern string, regex bool) (func(name string) bool, error) {
+	if regex {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid topic pattern '%s'", pattern)
+		}
+		return re.MatchString, nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, errors.Wrapf(err, "invalid topic pattern '%s'", pattern)
+	}
+	return func(name string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	}, nil
+}
+
+// runOnTopics runs the command on the topics with a bounded number of concurrent topics, the
+// output of the command for each topic is discarded in favor of the summary of the results
+func runOnTopics(vc *cmdutils.VerbCmd, topics []string, concurrency int,
+	cmd func(vc *cmdutils.VerbCmd) error, oc *cmdutils.OutputConfig) []BulkResult {
+	results := make([]BulkResult, len(topics))
+	extraArgs := vc.NameArgs
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				command := &cobra.Command{}
+				command.SetOut(io.Discard)
+				command.SetErr(io.Discard)
+				topicVC := &cmdutils.VerbCmd{
+					Command:      command,
+					FlagSetGroup: vc.FlagSetGroup,
+					NameArg:      topics[i],
+					NameArgs:     append([]string{topics[i]}, extraArgs...),
+					OutputConfig: oc,
+				}
+				results[i].Topic = topics[i]
+				if err := cmd(topicVC); err != nil {
+					results[i].Error = err.Error()
+				}
+			}
+		}()
+	}
+	for i := range topics {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+func writeBulkResults(vc *cmdutils.VerbCmd, oc *cmdutils.OutputConfig, results []BulkResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+
+	content := cmdutils.NewOutputContent().
+		WithObject(results).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Result"})
+			for _, r := range results {
+				result := "OK"
+				if r.Error != "" {
+					result = "FAILED: " + r.Error
+				}
+				table.Append([]string{r.Topic, result})
+			}
+			table.Render()
+			_, err := fmt.Fprintf(w, "%d succeeded, %d failed\n", len(results)-failed, failed)
+			return err
+		})
+	if err := oc.WriteOutput(vc.Command.OutOrStdout(), content); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errors.Errorf("failed on %d of %d topics", failed, len(results))
+	}
+	return nil
+}
+
+// confirm prompts the question until it is answered with yes or no, it fails if the input
+// ends without an answer, for example when it is not a terminal
+func confirm(cmd *cobra.Command, question string) (bool, error) {
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	for {
+		cmd.Println(question + " (Y or N)")
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return false, err
+			}
+			return false, errors.New("confirmation required, use --yes to run without prompting")
+		}
+		switch strings.ToLower(strings.TrimSpace(scanner.Text())) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+	}
+}
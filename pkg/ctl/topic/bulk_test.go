@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func TestBulkDeleteCmd(t *testing.T) {
+	for _, name := range []string{"test-bulk-delete-a", "test-bulk-delete-b", "test-bulk-keep"} {
+		args := []string{"create", name, "0"}
+		_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+		assert.Nil(t, execErr)
+	}
+
+	args := []string{"delete", "--pattern", "public/default/test-bulk-delete-*", "--yes", "--non-partitioned"}
+	out, execErr, _, _ := TestTopicCommands(DeleteTopicCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "The following 2 topics match the pattern public/default/test-bulk-delete-*:\n"+
+		"  persistent://public/default/test-bulk-delete-a\n"+
+		"  persistent://public/default/test-bulk-delete-b\n")
+	assert.Contains(t, out.String(), "2 succeeded, 0 failed\n")
+
+	args = []string{"unload", "--pattern", "test-bulk-(delete|keep)", "--regex", "--yes"}
+	out, execErr, _, _ = TestTopicCommands(UnloadCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "The following 1 topics match the pattern test-bulk-(delete|keep):\n"+
+		"  persistent://public/default/test-bulk-keep\n")
+}
+
+func TestBulkArgError(t *testing.T) {
+	args := []string{"delete", "--pattern", "test-*", "test-bulk-topic"}
+	_, _, nameErr, _ := TestTopicCommands(DeleteTopicCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name can not be specified with the --pattern flag", nameErr.Error())
+
+	args = []string{"offload", "--pattern", "test-*"}
+	_, _, nameErr, _ = TestTopicCommands(OffloadCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "only 1 arguments are allowed to be used with the --pattern flag", nameErr.Error())
+
+	args = []string{"delete", "--pattern", "test-*", "--concurrency", "0"}
+	_, execErr, _, _ := TestTopicCommands(DeleteTopicCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the concurrency must be greater than 0", execErr.Error())
+
+	args = []string{"delete", "--pattern", "public/default/test-*", "--all-namespaces"}
+	_, execErr, _, _ = TestTopicCommands(DeleteTopicCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the topic pattern can not contain a namespace when --all-namespaces is specified",
+		execErr.Error())
+}
+
+// TestBulkRemoveCmdWithPattern runs a command without the output flag on the topics matched
+// by a pattern against a fake admin server
+func TestBulkRemoveCmdWithPattern(t *testing.T) {
+	var mu sync.Mutex
+	var removed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/partitioned"):
+			_, _ = w.Write([]byte("[]"))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/admin/v2/persistent/"):
+			_, _ = w.Write([]byte(`["persistent://public/default/test-bulk-a",` +
+				`"persistent://public/default/test-bulk-b","persistent://public/default/other"]`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`["non-persistent://public/default/test-bulk-c"]`))
+		case r.Method == http.MethodDelete:
+			mu.Lock()
+			removed = append(removed, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	webServiceURL := cmdutils.PulsarCtlConfig.WebServiceURL
+	cmdutils.PulsarCtlConfig.WebServiceURL = server.URL
+	defer func() {
+		cmdutils.PulsarCtlConfig.WebServiceURL = webServiceURL
+	}()
+
+	args := []string{"remove-message-ttl", "--pattern", "persistent://public/default/test-bulk-*", "--yes"}
+	out, execErr, _, _ := TestTopicCommands(RemoveMessageTTLCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "2 succeeded, 0 failed\n")
+
+	sort.Strings(removed)
+	assert.Equal(t, []string{
+		"/admin/v2/persistent/public/default/test-bulk-a/messageTTL",
+		"/admin/v2/persistent/public/default/test-bulk-b/messageTTL",
+	}, removed)
+}
+
+func TestParseTopicPattern(t *testing.T) {
+	p, err := parseTopicPattern("test-*", false)
+	assert.Nil(t, err)
+	assert.Equal(t, &topicPattern{namespace: "public/default", topic: "test-*"}, p)
+
+	p, err = parseTopicPattern("persistent://my-tenant/my-ns/test-*", false)
+	assert.Nil(t, err)
+	assert.Equal(t, &topicPattern{domain: "persistent", namespace: "my-tenant/my-ns", topic: "test-*"}, p)
+
+	p, err = parseTopicPattern("non-persistent://test-*", true)
+	assert.Nil(t, err)
+	assert.Equal(t, &topicPattern{domain: "non-persistent", namespace: "public/default", topic: "test-*"}, p)
+
+	_, err = parseTopicPattern("http://my-tenant/my-ns/test-*", false)
+	assert.NotNil(t, err)
+
+	_, err = parseTopicPattern("persistent://my-tenant/my-ns/test-*", true)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the topic pattern can not contain a namespace when --all-namespaces is specified", err.Error())
+}
+
+func TestNewTopicMatcher(t *testing.T) {
+	match, err := newTopicMatcher("test-*", false)
+	assert.Nil(t, err)
+	assert.True(t, match("test-a"))
+	assert.False(t, match("other-test-a"))
+
+	match, err = newTopicMatcher("test-[0-9]+", true)
+	assert.Nil(t, err)
+	assert.True(t, match("test-42"))
+	assert.False(t, match("test-42-a"))
+
+	_, err = newTopicMatcher("test-[", false)
+	assert.NotNil(t, err)
+
+	_, err = newTopicMatcher("test-(", true)
+	assert.NotNil(t, err)
+}
+
+func TestConfirm(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetOut(&strings.Builder{})
+
+	cmd.SetIn(strings.NewReader("maybe\ny\n"))
+	ok, err := confirm(cmd, "Continue?")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	cmd.SetIn(strings.NewReader("NO\n"))
+	ok, err = confirm(cmd, "Continue?")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	cmd.SetIn(strings.NewReader(""))
+	ok, err = confirm(cmd, "Continue?")
+	assert.NotNil(t, err)
+	assert.Equal(t, "confirmation required, use --yes to run without prompting", err.Error())
+	assert.False(t, ok)
+}
@@ -62,7 +62,7 @@ func CompactCmd(vc *cmdutils.VerbCmd) {
 
 	var partition int
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doCompact(vc, partition)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// connectionStats is the part of the topic stats about the producers and the consumers,
+// the consumer stats of the admin client leave out the key hash ranges
+type connectionStats struct {
+	Publishers    []utils.PublisherStats                 `json:"publishers"`
+	Subscriptions map[string]subscriptionConnectionStats `json:"subscriptions"`
+}
+
+type subscriptionConnectionStats struct {
+	Type      string                    `json:"type"`
+	Consumers []consumerConnectionStats `json:"consumers"`
+}
+
+type consumerConnectionStats struct {
+	utils.ConsumerStats
+	KeyHashRanges []string `json:"keyHashRanges"`
+}
+
+type partitionedConnectionStats struct {
+	Partitions map[string]connectionStats `json:"partitions"`
+}
+
+// ConsumerConnection is a consumer connected to a topic
+type ConsumerConnection struct {
+	Topic            string   `json:"topic"`
+	Subscription     string   `json:"subscription"`
+	SubscriptionType string   `json:"subscriptionType"`
+	ConsumerName     string   `json:"consumerName"`
+	Address          string   `json:"address"`
+	ClientVersion    string   `json:"clientVersion"`
+	ConnectedSince   string   `json:"connectedSince"`
+	MsgRateOut       float64  `json:"msgRateOut"`
+	MsgThroughputOut float64  `json:"msgThroughputOut"`
+	AvailablePermits int      `json:"availablePermits"`
+	UnackedMessages  int      `json:"unackedMessages"`
+	Blocked          bool     `json:"blockedConsumerOnUnackedMsgs"`
+	KeyHashRanges    []string `json:"keyHashRanges,omitempty"`
+}
+
+// ProducerConnection is a producer connected to a topic
+type ProducerConnection struct {
+	Topic           string  `json:"topic"`
+	ProducerName    string  `json:"producerName"`
+	ProducerID      int64   `json:"producerId"`
+	AccessMode      string  `json:"accessMode"`
+	Address         string  `json:"address"`
+	ClientVersion   string  `json:"clientVersion"`
+	ConnectedSince  string  `json:"connectedSince"`
+	MsgRateIn       float64 `json:"msgRateIn"`
+	MsgThroughputIn float64 `json:"msgThroughputIn"`
+}
+
+// fetchConnectionStats fetches the stats of a topic, or of each partition of a
+// partitioned topic, keyed by the topic or the partition name
+func fetchConnectionStats(topic utils.TopicName) (map[string]connectionStats, error) {
+	admin := cmdutils.NewPulsarClient()
+	rc := cmdutils.NewPulsarRestClient()
+
+	partitions := 0
+	if topic.GetPartitionIndex() < 0 {
+		meta, err := admin.Topics().GetMetadata(topic)
+		if err != nil {
+			return nil, err
+		}
+		partitions = meta.Partitions
+	}
+
+	if partitions == 0 {
+		var s connectionStats
+		if err := rc.Get(rc.Endpoint("", topic.GetRestPath(), "stats"), &s); err != nil {
+			return nil, err
+		}
+		return map[string]connectionStats{topic.String(): s}, nil
+	}
+
+	var s partitionedConnectionStats
+	_, err := rc.GetWithQueryParams(rc.Endpoint("", topic.GetRestPath(), "partitioned-stats"), &s,
+		map[string]string{"perPartition": "true"}, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.Partitions, nil
+}
+
+func consumerConnections(stats map[string]connectionStats, address, subscription string) []ConsumerConnection {
+	conns := make([]ConsumerConnection, 0)
+	for topic, s := range stats {
+		for subName, sub := range s.Subscriptions {
+			if subscription != "" && subName != subscription {
+				continue
+			}
+			for _, c := range sub.Consumers {
+				if !strings.Contains(c.Address, address) {
+					continue
+				}
+				conns = append(conns, ConsumerConnection{
+					Topic:            topic,
+					Subscription:     subName,
+					SubscriptionType: sub.Type,
+					ConsumerName:     c.ConsumerName,
+					Address:          c.Address,
+					ClientVersion:    c.ClientVersion,
+					ConnectedSince:   c.ConnectedSince,
+					MsgRateOut:       c.MsgRateOut,
+					MsgThroughputOut: c.MsgThroughputOut,
+					AvailablePermits: c.AvailablePermits,
+					UnackedMessages:  c.UnAckedMessages,
+					Blocked:          c.BlockedConsumerOnUnAckedMsgs,
+					KeyHashRanges:    c.KeyHashRanges,
+				})
+			}
+		}
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].Topic != conns[j].Topic {
+			return conns[i].Topic < conns[j].Topic
+		}
+		if conns[i].Subscription != conns[j].Subscription {
+			return conns[i].Subscription < conns[j].Subscription
+		}
+		return conns[i].ConsumerName < conns[j].ConsumerName
+	})
+	return conns
+}
+
+func producerConnections(stats map[string]connectionStats, address string) []ProducerConnection {
+	conns := make([]ProducerConnection, 0)
+	for topic, s := range stats {
+		for _, p := range s.Publishers {
+			if !strings.Contains(p.Address, address) {
+				continue
+			}
+			conns = append(conns, ProducerConnection{
+				Topic:           topic,
+				ProducerName:    p.ProducerName,
+				ProducerID:      p.ProducerID,
+				AccessMode:      string(p.AccessModel),
+				Address:         p.Address,
+				ClientVersion:   p.ClientVersion,
+				ConnectedSince:  p.ConnectedSince,
+				MsgRateIn:       p.MsgRateIn,
+				MsgThroughputIn: p.MsgThroughputIn,
+			})
+		}
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].Topic != conns[j].Topic {
+			return conns[i].Topic < conns[j].Topic
+		}
+		return conns[i].ProducerName < conns[j].ProducerName
+	})
+	return conns
+}
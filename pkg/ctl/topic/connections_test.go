@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnections(t *testing.T) {
+	var partition connectionStats
+	err := json.Unmarshal([]byte(`{
+		"publishers": [
+			{"producerName": "p2", "producerId": 1, "address": "/10.0.0.2:1000", "accessMode": "Shared"},
+			{"producerName": "p1", "producerId": 0, "address": "/10.0.0.1:1000", "accessMode": "Shared"}
+		],
+		"subscriptions": {
+			"s1": {
+				"type": "Key_Shared",
+				"consumers": [
+					{"consumerName": "c1", "address": "/10.0.0.1:2000", "availablePermits": 10,
+						"keyHashRanges": ["[0, 32767]"]},
+					{"consumerName": "c2", "address": "/10.0.0.2:2000", "blockedConsumerOnUnackedMsgs": true}
+				]
+			},
+			"s2": {"type": "Shared", "consumers": [{"consumerName": "c3", "address": "/10.0.0.1:3000"}]}
+		}
+	}`), &partition)
+	assert.Nil(t, err)
+	stats := map[string]connectionStats{"t-partition-0": partition}
+
+	consumers := consumerConnections(stats, "", "")
+	assert.Len(t, consumers, 3)
+	assert.Equal(t, "c1", consumers[0].ConsumerName)
+	assert.Equal(t, "Key_Shared", consumers[0].SubscriptionType)
+	assert.Equal(t, 10, consumers[0].AvailablePermits)
+	assert.Equal(t, []string{"[0, 32767]"}, consumers[0].KeyHashRanges)
+	assert.True(t, consumers[1].Blocked)
+
+	consumers = consumerConnections(stats, "10.0.0.1:", "")
+	assert.Len(t, consumers, 2)
+	consumers = consumerConnections(stats, "10.0.0.1:", "s2")
+	assert.Len(t, consumers, 1)
+	assert.Equal(t, "c3", consumers[0].ConsumerName)
+
+	producers := producerConnections(stats, "")
+	assert.Len(t, producers, 2)
+	assert.Equal(t, "p1", producers[0].ProducerName)
+	producers = producerConnections(stats, "10.0.0.2")
+	assert.Len(t, producers, 1)
+	assert.Equal(t, int64(1), producers[0].ProducerID)
+}
+
+func TestConsumersArgsError(t *testing.T) {
+	args := []string{"consumers"}
+	_, _, nameErr, _ := TestTopicCommands(ConsumersCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestProducersArgsError(t *testing.T) {
+	args := []string{"producers"}
+	_, _, nameErr, _ := TestTopicCommands(ProducersCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestConsumersAndProducers(t *testing.T) {
+	topic := "persistent://public/default/test-connections-topic"
+	args := []string{"create", topic, "2"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"consumers", topic, "-o", "json"}
+	out, execErr, _, _ := TestTopicCommands(ConsumersCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "[]\n", out.String())
+
+	args = []string{"producers", topic}
+	out, execErr, _, _ = TestTopicCommands(ProducersCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), "PRODUCER")
+}
@@ -0,0 +1,130 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func ConsumersCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the consumers connected to a topic, along with the " +
+		"address, the client version, the rates, the available permits, the unacknowledged messages and the " +
+		"key hash ranges of each connection. The consumers of all the partitions of a partitioned topic " +
+		"are listed."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	consumers := cmdutils.Example{
+		Desc:    "List the consumers of a topic (topic-name)",
+		Command: "pulsarctl topics consumers (topic-name)",
+	}
+	consumersAddress := cmdutils.Example{
+		Desc:    "List the consumers of a subscription (subscription-name) connected from a host",
+		Command: "pulsarctl topics consumers (topic-name) --subscription (subscription-name) --address 10.0.0.12",
+	}
+	examples = append(examples, consumers, consumersAddress)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+--------------------------------------+--------------+------------+----------+-----------------+--------------------+----------------------+----------+----------------+---------+---------+---------+-----------------+
+|                TOPIC                 | SUBSCRIPTION |    TYPE    | CONSUMER |     ADDRESS     |      VERSION       |   CONNECTED SINCE    | RATE OUT | THROUGHPUT OUT | PERMITS | UNACKED | BLOCKED | KEY HASH RANGES |
++--------------------------------------+--------------+------------+----------+-----------------+--------------------+----------------------+----------+----------------+---------+---------+---------+-----------------+
+| persistent://public/default/my-topic | my-sub       | Key_Shared | c1       | /10.0.0.12:5432 | Pulsar-Java-v3.0.0 | 2020-01-01T00:00:00Z |    10.00 |       10240.00 |    1000 |       0 | false   | [0, 32767]      |
++--------------------------------------+--------------+------------+----------+-----------------+--------------------+----------------------+----------+----------------+---------+---------+---------+-----------------+`,
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"consumers",
+		"List the consumers connected to a topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var address, subscription string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doListConsumers(vc, address, subscription)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Consumers", func(set *pflag.FlagSet) {
+		set.StringVar(&address, "address", "", "List only the consumers with an address containing the value")
+		set.StringVar(&subscription, "subscription", "", "List only the consumers of the subscription")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doListConsumers(vc *cmdutils.VerbCmd, address, subscription string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	stats, err := fetchConnectionStats(*topic)
+	if err != nil {
+		return err
+	}
+	conns := consumerConnections(stats, address, subscription)
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(conns).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Subscription", "Type", "Consumer", "Address", "Version",
+				"Connected Since", "Rate Out", "Throughput Out", "Permits", "Unacked", "Blocked", "Key Hash Ranges"})
+			for _, c := range conns {
+				table.Append([]string{
+					c.Topic,
+					c.Subscription,
+					c.SubscriptionType,
+					c.ConsumerName,
+					c.Address,
+					c.ClientVersion,
+					c.ConnectedSince,
+					strconv.FormatFloat(c.MsgRateOut, 'f', 2, 64),
+					strconv.FormatFloat(c.MsgThroughputOut, 'f', 2, 64),
+					strconv.Itoa(c.AvailablePermits),
+					strconv.Itoa(c.UnackedMessages),
+					strconv.FormatBool(c.Blocked),
+					strings.Join(c.KeyHashRanges, ", "),
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
 	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
 
 	"github.com/streamnative/pulsarctl/pkg/cmdutils"
 )
@@ -44,6 +45,12 @@ func CreateTopicCmd(vc *cmdutils.VerbCmd) {
 		Command: "pulsarctl topics create (topic-name) (partition-num)",
 	}
 	examples = append(examples, create)
+
+	createWithProperties := cmdutils.Example{
+		Desc:    "Create a topic (topic-name) with the properties team=payments and cost-center=cc-42",
+		Command: "pulsarctl topics create (topic-name) (partition-num) --property team=payments,cost-center=cc-42",
+	}
+	examples = append(examples, createWithProperties)
 	desc.CommandExamples = examples
 	vc.Command.Example = "#example \n command"
 
@@ -64,12 +71,19 @@ func CreateTopicCmd(vc *cmdutils.VerbCmd) {
 		desc.ExampleToString(),
 		"c")
 
+	var properties map[string]string
+
 	vc.SetRunFuncWithMultiNameArgs(func() error {
-		return doCreateTopic(vc)
+		return doCreateTopic(vc, properties)
 	}, CheckTopicNameTwoArgs)
+
+	vc.FlagSetGroup.InFlagSet("Create Topic", func(set *pflag.FlagSet) {
+		set.StringToStringVarP(&properties, "property", "p", nil,
+			"Properties of the topic (key=value), can be specified multiple times")
+	})
 }
 
-func doCreateTopic(vc *cmdutils.VerbCmd) error {
+func doCreateTopic(vc *cmdutils.VerbCmd, properties map[string]string) error {
 	// for testing
 	if vc.NameError != nil {
 		return vc.NameError
@@ -86,7 +100,11 @@ func doCreateTopic(vc *cmdutils.VerbCmd) error {
 	}
 
 	admin := cmdutils.NewPulsarClient()
-	err = admin.Topics().Create(*topic, partitions)
+	if len(properties) > 0 {
+		err = admin.Topics().CreateWithProperties(*topic, partitions, properties)
+	} else {
+		err = admin.Topics().Create(*topic, partitions)
+	}
 	if err == nil {
 		vc.Command.Printf("Create topic %s with %d partitions successfully\n", topic.String(), partitions)
 	}
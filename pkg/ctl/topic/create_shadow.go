@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func CreateShadowCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for creating a shadow topic of a source topic. The shadow topic " +
+		"is created with the same number of partitions as the source topic and is added to the shadow topics " +
+		"of the source topic, so the messages of the source topic can be read from the shadow topic."
+	desc.CommandPermission = "This command requires namespace admin permissions."
+	desc.CommandScope = "non-partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	create := cmdutils.Example{
+		Desc:    "Create a shadow topic (shadow-topic) of the source topic (source-topic)",
+		Command: "pulsarctl topics create-shadow (shadow-topic) --source (source-topic)",
+	}
+	examples = append(examples, create)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Create shadow topic (shadow-topic) of (source-topic) successfully",
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError, TopicAlreadyExistError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"create-shadow",
+		"Create a shadow topic of a source topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var source string
+	var properties map[string]string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doCreateShadow(vc, source, properties)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Create Shadow", func(set *pflag.FlagSet) {
+		set.StringVar(&source, "source", "", "The source topic of the shadow topic")
+		set.StringToStringVarP(&properties, "property", "p", nil,
+			"Properties of the shadow topic (key=value), can be specified multiple times")
+		_ = cobra.MarkFlagRequired(set, "source")
+	})
+}
+
+func doCreateShadow(vc *cmdutils.VerbCmd, source string, properties map[string]string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	shadow, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+	sourceTopic, err := utils.GetTopicName(source)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	meta, err := admin.Topics().GetMetadata(*sourceTopic)
+	if err != nil {
+		return err
+	}
+
+	props := map[string]string{shadowSourceProperty: sourceTopic.String()}
+	for k, v := range properties {
+		props[k] = v
+	}
+	if err = admin.Topics().CreateWithProperties(*shadow, meta.Partitions, props); err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	shadows, err := getShadowTopics(rc, *sourceTopic)
+	if err != nil {
+		return err
+	}
+	err = setShadowTopics(rc, *sourceTopic, addShadowTopic(shadows, shadow.String()))
+	if err == nil {
+		vc.Command.Printf("Create shadow topic %s of %s successfully\n", shadow.String(), sourceTopic.String())
+	}
+	return err
+}
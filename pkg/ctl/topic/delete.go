@@ -41,7 +41,12 @@ func DeleteTopicCmd(vc *cmdutils.VerbCmd) {
 		Command: "pulsarctl topics delete --non-partitioned (topic-name)",
 	}
 
-	examples = append(examples, deleteTopic, deleteNonPartitionedTopic)
+	deleteByPattern := cmdutils.Example{
+		Desc:    "Delete the topics whose names start with test- in the namespace (tenant/namespace)",
+		Command: "pulsarctl topics delete --pattern '(tenant/namespace)/test-*'",
+	}
+
+	examples = append(examples, deleteTopic, deleteNonPartitionedTopic, deleteByPattern)
 	desc.CommandExamples = examples
 	var out []cmdutils.Output
 	successOut := cmdutils.Output{
@@ -85,7 +90,7 @@ func DeleteTopicCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doDeleteTopic(vc, force, deleteSchema, nonPartitioned)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
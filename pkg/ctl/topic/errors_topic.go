@@ -98,3 +98,8 @@ var NamespaceErrors = []cmdutils.Output{
 		Out: "[✖]  Namespace name include unsupported special chars. namespace : [<namespace>]",
 	},
 }
+
+var AppliedEffectiveError = cmdutils.Output{
+	Desc: "the --applied and --effective flags are specified together",
+	Out:  "[✖]  the --applied and --effective flags can not be used together",
+}
@@ -0,0 +1,172 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+type exportOptions struct {
+	from       string
+	to         string
+	file       string
+	decode     bool
+	serviceURL string
+}
+
+func ExportCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for exporting the messages of a topic to a file. Each line of " +
+		"the file is a JSON object holding the message id, the base64 encoded payload, the key, the properties " +
+		"and the timestamps of a message. The file can be imported to a topic with `pulsarctl topics import`."
+	desc.CommandPermission = "This command requires namespace consume permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	export := cmdutils.Example{
+		Desc:    "Export all the messages of a topic (topic-name) to a file",
+		Command: "pulsarctl topics export (topic-name) -f out.ndjson",
+	}
+	exportTime := cmdutils.Example{
+		Desc: "Export the messages of a topic (topic-name) published in the last hour " +
+			"along with the payload decoded with the topic schema",
+		Command: "pulsarctl topics export (topic-name) --from -1h --decode -f out.ndjson",
+	}
+	exportID := cmdutils.Example{
+		Desc:    "Export the messages of a topic (topic-name) between two message ids",
+		Command: "pulsarctl topics export (topic-name) --from 10:0 --to 12:100 -f out.ndjson",
+	}
+	examples = append(examples, export, exportTime, exportID)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Exported (n) messages of the topic (topic-name) to (file)",
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"export",
+		"Export the messages of a topic to a file",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"export")
+
+	opts := exportOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doExport(vc, &opts)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Export", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.from, "from", "",
+			"The position to export from: earliest, a message id (ledgerID:entryID), an RFC3339 time, "+
+				"a unix timestamp in milliseconds or a relative time such as -1h. Defaults to earliest")
+		set.StringVar(&opts.to, "to", "",
+			"The position to export to, latest or the same forms as --from except earliest. "+
+				"Defaults to the last message "+
+				"published before the export started")
+		set.StringVarP(&opts.file, "file", "f", "-", "The file to export the messages to, - means stdout")
+		set.BoolVar(&opts.decode, "decode", false,
+			"Add the payload decoded with the topic schema to each exported message")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+}
+
+func doExport(vc *cmdutils.VerbCmd, opts *exportOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	partitions, err := cmdutils.TopicPartitions(admin.Topics(), *topic)
+	if err != nil {
+		return err
+	}
+	r, err := parseRange(opts.from, opts.to, len(partitions))
+	if err != nil {
+		return err
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var w io.Writer = vc.Command.OutOrStdout()
+	if opts.file != "-" {
+		f, err := os.Create(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	var decoder *message.Decoder
+	if opts.decode {
+		decoder = message.NewDecoder(message.AdminSchemaFetcher(admin.Schemas(), topic.String()))
+	}
+
+	count := 0
+	for _, p := range partitions {
+		err = message.ReadRange(context.Background(), client, p.String(), r, func(msg pulsar.Message) (bool, error) {
+			m := message.FromPulsarMessage(msg)
+			if decoder != nil {
+				decoder.Decode(m)
+			}
+			count++
+			return true, enc.Encode(m)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = bw.Flush(); err != nil {
+		return err
+	}
+	if opts.file != "-" {
+		vc.Command.Printf("Exported %d messages of the topic %s to %s\n", count, topic.String(), opts.file)
+	}
+	return nil
+}
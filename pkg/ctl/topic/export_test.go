@@ -0,0 +1,140 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+func TestExportArgsError(t *testing.T) {
+	args := []string{"export"}
+	_, _, nameErr, _ := TestTopicCommands(ExportCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestImportArgsError(t *testing.T) {
+	args := []string{"import"}
+	_, _, nameErr, _ := TestTopicCommands(ImportCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestParseRangeMessageIDOnPartitionedTopic(t *testing.T) {
+	_, err := parseRange("10:0", "", 2)
+	assert.NotNil(t, err)
+
+	_, err = parseRange("-1h", "latest", 2)
+	assert.Nil(t, err)
+
+	r, err := parseRange("10:0", "12:3", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), r.FromID.LedgerID())
+	assert.Equal(t, int64(12), r.ToID.LedgerID())
+}
+
+func TestParseRangeInvalidBounds(t *testing.T) {
+	_, err := parseRange("", "earliest", 1)
+	assert.NotNil(t, err)
+	assert.Equal(t, "earliest can not be used as the upper bound, nothing would be read up to it", err.Error())
+
+	_, err = parseRange("latest", "", 1)
+	assert.NotNil(t, err)
+	assert.Equal(t, "latest can not be used as the lower bound, nothing would be read from it", err.Error())
+
+	r, err := parseRange("earliest", "latest", 1)
+	assert.Nil(t, err)
+	assert.Nil(t, r.ToID)
+}
+
+func TestToProducerMessage(t *testing.T) {
+	opts := &importOptions{preserveKey: true}
+	pm, err := toProducerMessage(&message.Message{Key: "k", Value: "hello",
+		Properties: map[string]string{"a": "b"}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "k", pm.Key)
+	assert.Equal(t, []byte("hello"), pm.Payload)
+	assert.Nil(t, pm.Properties)
+
+	pm, err = toProducerMessage(&message.Message{Value: map[string]interface{}{"a": 1.0}}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"a":1}`), pm.Payload)
+
+	pm, err = toProducerMessage(&message.Message{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{}, pm.Payload)
+}
+
+func TestExportImportEmptyMessage(t *testing.T) {
+	b, err := json.Marshal(&message.Message{MessageID: "1:2:-1:-1", Key: "k", Payload: []byte{}})
+	assert.Nil(t, err)
+
+	var m message.Message
+	assert.Nil(t, json.Unmarshal(b, &m))
+	pm, err := toProducerMessage(&m, &importOptions{preserveKey: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "k", pm.Key)
+	assert.Empty(t, pm.Payload)
+}
+
+func TestExportAndImport(t *testing.T) {
+	from := "persistent://public/default/test-export-topic"
+	to := "persistent://public/default/test-import-topic"
+	file := filepath.Join(t.TempDir(), "out.ndjson")
+
+	args := []string{"create", from, "0"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	in := filepath.Join(t.TempDir(), "in.ndjson")
+	assert.Nil(t, os.WriteFile(in, []byte(`{"key":"k1","value":"m1"}`+"\n"+`{"key":"k2","value":"m2"}`+"\n"), 0600))
+	args = []string{"import", from, "-f", in}
+	out, execErr, _, _ := TestTopicCommands(ImportCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Imported 2 messages to the topic "+from+"\n", out.String())
+
+	args = []string{"export", from, "-f", file}
+	out, execErr, _, _ = TestTopicCommands(ExportCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Exported 2 messages of the topic "+from+" to "+file+"\n", out.String())
+
+	f, err := os.Open(file)
+	assert.Nil(t, err)
+	defer f.Close()
+	var keys []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var m message.Message
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &m))
+		keys = append(keys, m.Key)
+	}
+	assert.Equal(t, []string{"k1", "k2"}, keys)
+
+	args = []string{"import", to, "-f", file}
+	out, execErr, _, _ = TestTopicCommands(ImportCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Imported 2 messages to the topic "+to+"\n", out.String())
+}
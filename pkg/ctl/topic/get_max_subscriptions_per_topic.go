@@ -0,0 +1,99 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func GetMaxSubscriptionsPerTopicCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Get max number of subscriptions for a topic"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Get max number of subscriptions for a topic",
+		Command: "pulsarctl topics get-max-subscriptions-per-topic topic",
+	}
+	appliedMsg := cmdutils.Example{
+		Desc:    "Get the applied max number of subscriptions for a topic",
+		Command: "pulsarctl topics get-max-subscriptions-per-topic topic --applied",
+	}
+	examples = append(examples, msg, appliedMsg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "10",
+	}
+	notSetOut := cmdutils.Output{
+		Desc: "the policy is not set",
+		Out:  "not set",
+	}
+	out = append(out, successOut, notSetOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-max-subscriptions-per-topic",
+		"Get max number of subscriptions for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"get-max-subscriptions-per-topic",
+	)
+
+	var applied bool
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetMaxSubscriptionsPerTopic(vc, applied)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("MaxSubscriptionsPerTopic", func(set *pflag.FlagSet) {
+		set.BoolVarP(&applied, "applied", "a", false,
+			"Get the applied policy for the topic")
+	})
+}
+
+func doGetMaxSubscriptionsPerTopic(vc *cmdutils.VerbCmd, applied bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	value := -1
+	isSet, err := getTopicPolicy(topic, "maxSubscriptionsPerTopic", applied, &value)
+	if err == nil {
+		if !isSet || value == -1 {
+			vc.Command.Print("not set")
+		} else {
+			vc.Command.Print(value)
+		}
+	}
+	return err
+}
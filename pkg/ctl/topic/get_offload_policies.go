@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func GetOffloadPoliciesCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Get the offload policies for a topic"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Get the offload policies for a topic",
+		Command: "pulsarctl topics get-offload-policies topic",
+	}
+	appliedMsg := cmdutils.Example{
+		Desc:    "Get the applied offload policies for a topic",
+		Command: "pulsarctl topics get-offload-policies topic --applied",
+	}
+	examples = append(examples, msg, appliedMsg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"managedLedgerOffloadDriver\": \"aws-s3\",\n" +
+			"  \"managedLedgerOffloadThresholdInBytes\": 10485760,\n" +
+			"  \"managedLedgerOffloadThresholdInSeconds\": -1,\n" +
+			"  \"managedLedgerOffloadDeletionLagInMillis\": 14400000,\n" +
+			"  \"s3ManagedLedgerOffloadBucket\": \"bucket\",\n" +
+			"  \"s3ManagedLedgerOffloadRegion\": \"us-west-2\"\n" +
+			"}",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-offload-policies",
+		"Get the offload policies for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"get-offload-policies",
+	)
+
+	var applied bool
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetOffloadPolicies(vc, applied)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("OffloadPolicies", func(set *pflag.FlagSet) {
+		set.BoolVarP(&applied, "applied", "a", false,
+			"Get the applied policy for the topic")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doGetOffloadPolicies(vc *cmdutils.VerbCmd, applied bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	var policies *ctlutils.OffloadPolicies
+	_, err = getTopicPolicy(topic, "offloadPolicies", applied, &policies)
+	if err == nil {
+		oc := cmdutils.NewOutputContent().WithObject(policies)
+		err = vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+	}
+	return err
+}
@@ -0,0 +1,116 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"io"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func GetPoliciesCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Get the effective policies of a topic. For each policy the value at the topic, " +
+		"namespace and broker level is shown, along with the effective value and the level it comes from."
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Get the effective policies of a topic",
+		Command: "pulsarctl topics policies topic",
+	}
+	examples = append(examples, msg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-----------------------------+-----------------+-----------+-----------------+-----------+--------+\n" +
+			"|           POLICY            |    EFFECTIVE    |  SOURCE   |      TOPIC      | NAMESPACE | BROKER |\n" +
+			"+-----------------------------+-----------------+-----------+-----------------+-----------+--------+\n" +
+			"| messageTTL                  | 3600            | namespace | -               | 3600      | 0      |\n" +
+			"| maxProducers                | 0               | broker    | -               | -         | 0      |\n" +
+			"| schemaCompatibilityStrategy | FULL_TRANSITIVE | topic     | FULL_TRANSITIVE | -         | FULL   |\n" +
+			"+-----------------------------+-----------------+-----------+-----------------+-----------+--------+",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"policies",
+		"Get the effective policies of a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"policies",
+	)
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetPolicies(vc)
+	}, "the topic name is not specified or the topic name is specified more than one")
+	vc.EnableOutputFlagSet()
+}
+
+func doGetPolicies(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	policies, err := fetchEffectivePolicies(ctlutils.PolicyDefinitions, topic)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(policies).
+		WithTextFunc(func(w io.Writer) error {
+			return ctlutils.WriteEffectivePolicies(w, policies, true)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+// fetchEffectivePolicies resolves the policies of the topic at the topic, namespace and broker level
+func fetchEffectivePolicies(defs []ctlutils.PolicyDefinition,
+	topic *utils.TopicName) ([]ctlutils.EffectivePolicy, error) {
+	rc := cmdutils.NewPulsarRestClient()
+	topicGetter := ctlutils.NewPolicyLevelGetter(func(path string) ([]byte, error) {
+		return rc.GetWithQueryParams(rc.Endpoint("", topic.GetRestPath(), path), nil,
+			map[string]string{"applied": "false"}, false)
+	})
+	namespace := topic.GetTenant() + "/" + topic.GetNamespace()
+	namespaceGetter := ctlutils.NewPolicyLevelGetter(func(path string) ([]byte, error) {
+		return rc.GetWithQueryParams(rc.Endpoint("/namespaces", namespace, path), nil, nil, false)
+	})
+
+	brokerConfig, err := cmdutils.NewPulsarClient().Brokers().GetRuntimeConfigurations()
+	if err != nil {
+		return nil, err
+	}
+	return ctlutils.ResolveEffectivePolicies(defs, topicGetter, namespaceGetter, brokerConfig)
+}
@@ -0,0 +1,63 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func TestGetPolicies(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-effective-policies-topic"
+	args := []string{"create", topicName, "0"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	setArgs := []string{"set-max-producers", topicName, "-p", "10"}
+	_, execErr, _, _ = TestTopicCommands(SetMaxProducersCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	args = []string{"policies", topicName, "-o", "json"}
+	g.Eventually(func(g gomega.Gomega) {
+		out, execErr, _, _ := TestTopicCommands(GetPoliciesCmd, args)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var policies []ctlutils.EffectivePolicy
+		g.Expect(json.Unmarshal(out.Bytes(), &policies)).Should(gomega.Succeed())
+		g.Expect(policies).Should(gomega.HaveLen(len(ctlutils.PolicyDefinitions)))
+		for _, p := range policies {
+			if p.Policy == "maxProducers" {
+				g.Expect(p.Value).Should(gomega.Equal(float64(10)))
+				g.Expect(p.Source).Should(gomega.Equal(ctlutils.PolicyLevelTopic))
+			}
+		}
+	}).Should(gomega.Succeed())
+}
+
+func TestGetPoliciesArgError(t *testing.T) {
+	args := []string{"policies"}
+	_, _, nameErr, _ := TestTopicCommands(GetPoliciesCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
@@ -0,0 +1,127 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func GetSchemaCompatibilityStrategyCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Get the schema compatibility strategy for a topic"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Get the schema compatibility strategy for a topic",
+		Command: "pulsarctl topics get-schema-compatibility-strategy topic",
+	}
+	appliedMsg := cmdutils.Example{
+		Desc:    "Get the applied schema compatibility strategy for a topic",
+		Command: "pulsarctl topics get-schema-compatibility-strategy topic --applied",
+	}
+	effectiveMsg := cmdutils.Example{
+		Desc: "Get the schema compatibility strategy at the topic, namespace and broker level, " +
+			"and where the effective strategy comes from",
+		Command: "pulsarctl topics get-schema-compatibility-strategy topic --effective",
+	}
+	examples = append(examples, msg, appliedMsg, effectiveMsg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "FULL_TRANSITIVE",
+	}
+	notSetOut := cmdutils.Output{
+		Desc: "the policy is not set",
+		Out:  "not set",
+	}
+	effectiveOut := cmdutils.Output{
+		Desc: "the effective policy",
+		Out: "+-----------------------------+-----------------+--------+-----------------+-----------+--------+\n" +
+			"|           POLICY            |    EFFECTIVE    | SOURCE |      TOPIC      | NAMESPACE | BROKER |\n" +
+			"+-----------------------------+-----------------+--------+-----------------+-----------+--------+\n" +
+			"| schemaCompatibilityStrategy | FULL_TRANSITIVE | topic  | FULL_TRANSITIVE | BACKWARD  | FULL   |\n" +
+			"+-----------------------------+-----------------+--------+-----------------+-----------+--------+",
+	}
+	out = append(out, successOut, notSetOut, effectiveOut, ArgError, AppliedEffectiveError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"get-schema-compatibility-strategy",
+		"Get the schema compatibility strategy for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"get-schema-compatibility-strategy",
+	)
+
+	var applied, effective bool
+	vc.SetRunFuncWithNameArg(func() error {
+		return doGetSchemaCompatibilityStrategy(vc, applied, effective)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("SchemaCompatibilityStrategy", func(set *pflag.FlagSet) {
+		set.BoolVarP(&applied, "applied", "a", false,
+			"Get the applied policy for the topic")
+		set.BoolVarP(&effective, "effective", "e", false,
+			"Show the policy at the topic, namespace and broker level and the effective value")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doGetSchemaCompatibilityStrategy(vc *cmdutils.VerbCmd, applied, effective bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if applied && effective {
+		return errAppliedEffective
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	if effective {
+		p, err := effectiveTopicPolicy(topic, "schemaCompatibilityStrategy")
+		if err != nil {
+			return err
+		}
+		return writeEffectivePolicy(vc, p)
+	}
+
+	var strategy utils.SchemaCompatibilityStrategy
+	_, err = getTopicPolicy(topic, "schemaCompatibilityStrategy", applied, &strategy)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if strategy != "" && strategy != utils.SchemaCompatibilityStrategyUndefined {
+		value = strategy.String()
+	}
+	return writePolicyValue(vc, value)
+}
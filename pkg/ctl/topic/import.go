@@ -0,0 +1,209 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+type importOptions struct {
+	file               string
+	preserveKey        bool
+	preserveProperties bool
+	preserveEventTime  bool
+	serviceURL         string
+}
+
+func ImportCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for publishing the messages exported by " +
+		"`pulsarctl topics export` to a topic. The topic can be in another cluster by specifying " +
+		"the service urls of that cluster. A message without a payload is published with its value " +
+		"encoded in JSON, or as is if the value is a string. A message with neither of them is published " +
+		"with an empty payload."
+	desc.CommandPermission = "This command requires namespace produce permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	imp := cmdutils.Example{
+		Desc:    "Import the messages in a file to a topic (topic-name)",
+		Command: "pulsarctl topics import (topic-name) -f out.ndjson",
+	}
+	impWithoutKey := cmdutils.Example{
+		Desc:    "Import the messages in a file to a topic (topic-name) without their keys and event time",
+		Command: "pulsarctl topics import (topic-name) -f out.ndjson --preserve-key=false --preserve-event-time=false",
+	}
+	impCluster := cmdutils.Example{
+		Desc:    "Import the messages in a file to a topic (topic-name) of another cluster",
+		Command: "pulsarctl topics import (topic-name) -f out.ndjson -s http://other-cluster:8080",
+	}
+	examples = append(examples, imp, impWithoutKey, impCluster)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Imported (n) messages to the topic (topic-name)",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"import",
+		"Publish the messages exported from a topic to a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"import")
+
+	opts := importOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doImport(vc, &opts)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Import", func(set *pflag.FlagSet) {
+		set.StringVarP(&opts.file, "file", "f", "-", "The file to import the messages from, - means stdin")
+		set.BoolVar(&opts.preserveKey, "preserve-key", true, "Publish the messages with their keys")
+		set.BoolVar(&opts.preserveProperties, "preserve-properties", true,
+			"Publish the messages with their properties")
+		set.BoolVar(&opts.preserveEventTime, "preserve-event-time", true,
+			"Publish the messages with their event time")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+}
+
+func doImport(vc *cmdutils.VerbCmd, opts *importOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = vc.Command.InOrStdin()
+	if opts.file != "-" {
+		f, err := os.Open(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: topic.String()})
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	var mu sync.Mutex
+	var sendErr error
+	sent, failed := 0, 0
+	callback := func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed++
+			if sendErr == nil {
+				sendErr = err
+			}
+			return
+		}
+		sent++
+	}
+
+	dec := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var m message.Message
+		if err := dec.Decode(&m); err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrapf(err, "invalid message #%d", line)
+		}
+
+		pm, err := toProducerMessage(&m, opts)
+		if err != nil {
+			return errors.Wrapf(err, "invalid message #%d", line)
+		}
+		producer.SendAsync(context.Background(), pm, callback)
+	}
+
+	if err := producer.Flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to publish %d of %d messages to the topic %s: %v",
+			failed, sent+failed, topic.String(), sendErr)
+	}
+	vc.Command.Printf("Imported %d messages to the topic %s\n", sent, topic.String())
+	return nil
+}
+
+func toProducerMessage(m *message.Message, opts *importOptions) (*pulsar.ProducerMessage, error) {
+	pm := &pulsar.ProducerMessage{Payload: m.Payload}
+	if pm.Payload == nil {
+		switch v := m.Value.(type) {
+		case nil:
+			// an empty payload, such as a tombstone, is exported without the payload field
+			pm.Payload = []byte{}
+		case string:
+			pm.Payload = []byte(v)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			pm.Payload = b
+		}
+	}
+
+	if opts.preserveKey {
+		pm.Key = m.Key
+	}
+	if opts.preserveProperties {
+		pm.Properties = m.Properties
+	}
+	if opts.preserveEventTime && m.EventTime != nil {
+		pm.EventTime = *m.EventTime
+	}
+	return pm, nil
+}
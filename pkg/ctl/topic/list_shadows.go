@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func ListShadowsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the shadow topics of a source topic."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+	desc.CommandScope = "non-partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	list := cmdutils.Example{
+		Desc:    "List the shadow topics of the source topic (source-topic)",
+		Command: "pulsarctl topics list-shadows (source-topic)",
+	}
+	examples = append(examples, list)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "persistent://public/default/my-topic-shadow",
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"list-shadows",
+		"List the shadow topics of a source topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doListShadows(vc)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doListShadows(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	source, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	shadows, err := getShadowTopics(cmdutils.NewPulsarRestClient(), *source)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(shadows).
+		WithTextFunc(func(w io.Writer) error {
+			for _, s := range shadows {
+				if _, err := fmt.Fprintln(w, s); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
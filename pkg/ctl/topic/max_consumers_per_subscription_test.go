@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestMaxConsumersPerSubscription(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-max-consumers-per-subscription-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	getArgs := []string{"get-max-consumers-per-subscription", topicName}
+	getOut, execErr, _, _ := TestTopicCommands(GetMaxConsumersPerSubscriptionCmd, getArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(getOut.String()).Should(gomega.Equal("not set"))
+
+	setArgs := []string{"set-max-consumers-per-subscription", topicName, "-c", "20"}
+	setOut, execErr, _, _ := TestTopicCommands(SetMaxConsumersPerSubscriptionCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set max number of consumers per subscription successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetMaxConsumersPerSubscriptionCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("20"))
+	}).Should(gomega.Succeed())
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetMaxConsumersPerSubscriptionCmd,
+			append(getArgs, "--applied"))
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("20"))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-max-consumers-per-subscription", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveMaxConsumersPerSubscriptionCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove max number of consumers per subscription successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetMaxConsumersPerSubscriptionCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("not set"))
+	}).Should(gomega.Succeed())
+}
+
+func TestMaxConsumersPerSubscriptionArgError(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	args := []string{"get-max-consumers-per-subscription"}
+	_, _, nameErr, _ := TestTopicCommands(GetMaxConsumersPerSubscriptionCmd, args)
+	g.Expect(nameErr).ShouldNot(gomega.BeNil())
+	g.Expect(nameErr.Error()).Should(gomega.Equal(
+		"the topic name is not specified or the topic name is specified more than one"))
+}
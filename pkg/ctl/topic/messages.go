@@ -0,0 +1,70 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"math"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+// parseRange parses the bounds of the messages to read, an empty bound is left open.
+// A message id is only meaningful within a single partition.
+func parseRange(from, to string, partitions int) (message.Range, error) {
+	var r message.Range
+	now := time.Now()
+
+	if from != "" {
+		id, t, err := ctlutils.ParsePosition(from, now)
+		if err != nil {
+			return r, err
+		}
+		if id != nil && id.LedgerID() == math.MaxInt64 {
+			return r, errors.New("latest can not be used as the lower bound, nothing would be read from it")
+		}
+		r.FromID, r.FromTime = id, t
+	}
+	if to != "" {
+		id, t, err := ctlutils.ParsePosition(to, now)
+		if err != nil {
+			return r, err
+		}
+		if id != nil && id.LedgerID() < 0 {
+			return r, errors.New("earliest can not be used as the upper bound, nothing would be read up to it")
+		}
+		if id != nil && id.LedgerID() != math.MaxInt64 {
+			r.ToID = id
+		}
+		r.ToTime = t
+	}
+
+	if partitions > 1 && (isMessageIDBound(r.FromID) || isMessageIDBound(r.ToID)) {
+		return r, errors.New("a message id can only be used as a bound of a non-partitioned topic " +
+			"or a partition of a partitioned topic")
+	}
+	return r, nil
+}
+
+func isMessageIDBound(id pulsar.MessageID) bool {
+	return id != nil && id.LedgerID() >= 0 && id.LedgerID() != math.MaxInt64
+}
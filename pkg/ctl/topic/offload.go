@@ -68,9 +68,9 @@ func OffloadCmd(vc *cmdutils.VerbCmd) {
 		desc.ToString(),
 		desc.ExampleToString())
 
-	vc.SetRunFuncWithMultiNameArgs(func() error {
+	setRunFuncWithTopicSelectorArgs(vc, func(vc *cmdutils.VerbCmd) error {
 		return doOffload(vc)
-	}, func(args []string) error {
+	}, 1, func(args []string) error {
 		if len(args) != 2 {
 			return errors.New("only two arguments are allowed to be used as names")
 		}
@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func TestOffloadPolicies(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-offload-policies-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	getArgs := []string{"get-offload-policies", topicName}
+	getOut, execErr, _, _ := TestTopicCommands(GetOffloadPoliciesCmd, getArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(getOut.String()).Should(gomega.Equal("null"))
+
+	setArgs := []string{"set-offload-policies", topicName, "--driver", "aws-s3", "--bucket", "test-bucket",
+		"--region", "us-west-2", "--threshold", "10m", "--threshold-seconds", "1h", "--deletion-lag", "1m"}
+	setOut, execErr, _, _ := TestTopicCommands(SetOffloadPoliciesCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal("Set offload policies successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetOffloadPoliciesCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var policies ctlutils.OffloadPolicies
+		err := json.Unmarshal(getOut.Bytes(), &policies)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(policies.ManagedLedgerOffloadDriver).Should(gomega.Equal("aws-s3"))
+		g.Expect(policies.S3ManagedLedgerOffloadBucket).Should(gomega.Equal("test-bucket"))
+		g.Expect(policies.S3ManagedLedgerOffloadRegion).Should(gomega.Equal("us-west-2"))
+		g.Expect(policies.ManagedLedgerOffloadThresholdInBytes).Should(gomega.Equal(int64(10 * 1024 * 1024)))
+		g.Expect(policies.ManagedLedgerOffloadThresholdInSeconds).Should(gomega.Equal(int64(3600)))
+		g.Expect(policies.ManagedLedgerOffloadDeletionLagInMillis).Should(gomega.Equal(int64(60000)))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-offload-policies", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveOffloadPoliciesCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal("Remove offload policies successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetOffloadPoliciesCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("null"))
+	}).Should(gomega.Succeed())
+}
+
+func TestSetOffloadPoliciesArgError(t *testing.T) {
+	args := []string{"set-offload-policies", "test-topic", "--driver", "tape"}
+	_, execErr, _, _ := TestTopicCommands(SetOffloadPoliciesCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid offload driver 'tape', valid drivers are "+
+		"S3, aws-s3, google-cloud-storage, filesystem, azureblob, aliyun-oss", execErr.Error())
+
+	args = []string{"set-offload-policies", "test-topic"}
+	_, _, _, err := TestTopicCommands(SetOffloadPoliciesCmd, args)
+	assert.NotNil(t, err)
+	assert.Equal(t, "required flag(s) \"driver\" not set", err.Error())
+}
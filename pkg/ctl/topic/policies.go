@@ -0,0 +1,70 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+var subscriptionTypes = []string{"Exclusive", "Shared", "Failover", "Key_Shared"}
+
+// getTopicPolicy reads a topic level policy through the REST API, because the admin
+// client does not support the applied flag for every policy. It reports whether the
+// policy is set, when applied is true the namespace and broker level values are used
+// as the fallback.
+func getTopicPolicy(topic *utils.TopicName, policy string, applied bool, obj interface{}) (bool, error) {
+	rc := cmdutils.NewPulsarRestClient()
+	body, err := rc.GetWithQueryParams(rc.Endpoint("", topic.GetRestPath(), policy), obj,
+		map[string]string{"applied": strconv.FormatBool(applied)}, true)
+	if err != nil {
+		return false, err
+	}
+	body = bytes.TrimSpace(body)
+	return len(body) > 0 && !bytes.Equal(body, []byte("null")), nil
+}
+
+// parseSubscriptionTypes validates the given subscription types and converts
+// them to the names used by the broker
+func parseSubscriptionTypes(types []string) ([]string, error) {
+	if len(types) == 0 {
+		return nil, fmt.Errorf("at least one subscription type must be specified")
+	}
+	parsed := make([]string, 0, len(types))
+	for _, t := range types {
+		name := ""
+		for _, s := range subscriptionTypes {
+			if strings.EqualFold(s, strings.TrimSpace(t)) {
+				name = s
+				break
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("invalid subscription type '%s', valid types are %s",
+				t, strings.Join(subscriptionTypes, ", "))
+		}
+		parsed = append(parsed, name)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,123 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func ProducersCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for listing the producers connected to a topic, along with the " +
+		"address, the client version, the access mode and the rates of each connection. The producers of all " +
+		"the partitions of a partitioned topic are listed."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	producers := cmdutils.Example{
+		Desc:    "List the producers of a topic (topic-name)",
+		Command: "pulsarctl topics producers (topic-name)",
+	}
+	producersAddress := cmdutils.Example{
+		Desc:    "List the producers of a topic (topic-name) connected from a host",
+		Command: "pulsarctl topics producers (topic-name) --address 10.0.0.12",
+	}
+	examples = append(examples, producers, producersAddress)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+--------------------------------------+----------+----+-------------+-----------------+--------------------+----------------------+---------+---------------+
+|                TOPIC                 | PRODUCER | ID | ACCESS MODE |     ADDRESS     |      VERSION       |   CONNECTED SINCE    | RATE IN | THROUGHPUT IN |
++--------------------------------------+----------+----+-------------+-----------------+--------------------+----------------------+---------+---------------+
+| persistent://public/default/my-topic | p1       |  0 | Shared      | /10.0.0.12:5432 | Pulsar-Java-v3.0.0 | 2020-01-01T00:00:00Z |   10.00 |      10240.00 |
++--------------------------------------+----------+----+-------------+-----------------+--------------------+----------------------+---------+---------------+`,
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"producers",
+		"List the producers connected to a topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var address string
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doListProducers(vc, address)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Producers", func(set *pflag.FlagSet) {
+		set.StringVar(&address, "address", "", "List only the producers with an address containing the value")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doListProducers(vc *cmdutils.VerbCmd, address string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	stats, err := fetchConnectionStats(*topic)
+	if err != nil {
+		return err
+	}
+	conns := producerConnections(stats, address)
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(conns).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Producer", "ID", "Access Mode", "Address", "Version",
+				"Connected Since", "Rate In", "Throughput In"})
+			for _, p := range conns {
+				table.Append([]string{
+					p.Topic,
+					p.ProducerName,
+					strconv.FormatInt(p.ProducerID, 10),
+					p.AccessMode,
+					p.Address,
+					p.ClientVersion,
+					p.ConnectedSince,
+					strconv.FormatFloat(p.MsgRateIn, 'f', 2, 64),
+					strconv.FormatFloat(p.MsgThroughputIn, 'f', 2, 64),
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicProperties(t *testing.T) {
+	topicName := "persistent://public/default/test-topic-properties"
+	args := []string{"create", topicName, "0", "--property", "team=payments", "--property", "cost-center=cc-42"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	getProperties := func() map[string]string {
+		out, execErr, _, _ := TestTopicCommands(GetPropertiesCmd, []string{"get-properties", topicName})
+		assert.Nil(t, execErr)
+		var properties map[string]string
+		assert.Nil(t, json.Unmarshal(out.Bytes(), &properties))
+		return properties
+	}
+	assert.Equal(t, map[string]string{"team": "payments", "cost-center": "cc-42"}, getProperties())
+
+	args = []string{"update-properties", topicName, "-p", "team=billing,tier=gold"}
+	out, execErr, _, _ := TestTopicCommands(UpdatePropertiesCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Update properties successfully for ["+topicName+"]\n", out.String())
+	assert.Equal(t, map[string]string{"team": "billing", "cost-center": "cc-42", "tier": "gold"}, getProperties())
+
+	args = []string{"remove-property", topicName, "--key", "tier"}
+	out, execErr, _, _ = TestTopicCommands(RemovePropertyCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Remove property tier successfully for ["+topicName+"]\n", out.String())
+	assert.Equal(t, map[string]string{"team": "billing", "cost-center": "cc-42"}, getProperties())
+}
+
+func TestTopicPropertiesArgsError(t *testing.T) {
+	args := []string{"update-properties", "test-topic"}
+	_, _, _, err := TestTopicCommands(UpdatePropertiesCmd, args)
+	assert.NotNil(t, err)
+	assert.Equal(t, "required flag(s) \"property\" not set", err.Error())
+
+	args = []string{"remove-property", "test-topic"}
+	_, _, _, err = TestTopicCommands(RemovePropertyCmd, args)
+	assert.NotNil(t, err)
+	assert.Equal(t, "required flag(s) \"key\" not set", err.Error())
+}
@@ -66,7 +66,7 @@ func RemoveBacklogQuotaCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveBacklogQuota(vc, utils.BacklogQuotaType(backlogQuotaType))
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -50,7 +50,7 @@ func RemoveCompactionThresholdCmd(vc *cmdutils.VerbCmd) {
 		desc.ToString(),
 		desc.ExampleToString())
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveCompactionThreshold(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
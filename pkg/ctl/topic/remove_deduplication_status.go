@@ -54,7 +54,7 @@ func RemoveDeduplicationStatusCmd(vc *cmdutils.VerbCmd) {
 		"remove-deduplication",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveDeduplicationStatus(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -54,7 +54,7 @@ func RemoveDelayedDeliveryCmd(vc *cmdutils.VerbCmd) {
 		"remove-delayed-delivery",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveDelayedDelivery(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
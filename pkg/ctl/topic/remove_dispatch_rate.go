@@ -54,7 +54,7 @@ func RemoveDispatchRateCmd(vc *cmdutils.VerbCmd) {
 		"remove-dispatch-rate",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveDispatchRate(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
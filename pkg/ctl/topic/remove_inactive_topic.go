@@ -41,7 +41,7 @@ func RemoveInactiveTopicCmd(vc *cmdutils.VerbCmd) {
 		desc.ToString(),
 		desc.ExampleToString())
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveInactiveTopic(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
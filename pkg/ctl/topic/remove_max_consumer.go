@@ -54,7 +54,7 @@ func RemoveMaxConsumersCmd(vc *cmdutils.VerbCmd) {
 		"remove-max-consumers",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveMaxConsumers(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
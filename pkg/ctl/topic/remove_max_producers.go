@@ -54,7 +54,7 @@ func RemoveMaxProducersCmd(vc *cmdutils.VerbCmd) {
 		"remove-max-producers",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveMaxProducers(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
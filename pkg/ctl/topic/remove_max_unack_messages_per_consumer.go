@@ -54,7 +54,7 @@ func RemoveMaxUnackMessagesPerConsumerCmd(vc *cmdutils.VerbCmd) {
 		"remove-max-unacked-messages-per-consumer",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveMaxUnackMessagesPerConsumer(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
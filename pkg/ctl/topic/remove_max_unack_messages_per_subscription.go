@@ -54,7 +54,7 @@ func RemoveMaxUnackMessagesPerSubscriptionCmd(vc *cmdutils.VerbCmd) {
 		"remove-max-unacked-messages-per-subscription",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveMaxUnackMessagesPerSubscription(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
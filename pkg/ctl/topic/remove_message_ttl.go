@@ -53,7 +53,7 @@ func RemoveMessageTTLCmd(vc *cmdutils.VerbCmd) {
 		desc.ExampleToString(),
 		"remove-message-ttl",
 	)
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveMessageTTL(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
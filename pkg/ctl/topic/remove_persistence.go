@@ -53,7 +53,7 @@ func RemovePersistenceCmd(vc *cmdutils.VerbCmd) {
 		desc.ExampleToString(),
 		"remove-persistence",
 	)
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemovePersistence(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -55,7 +55,7 @@ func RemovePublishRateCmd(vc *cmdutils.VerbCmd) {
 		"remove-publish-rate",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemovePublishRate(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
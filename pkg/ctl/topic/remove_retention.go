@@ -68,7 +68,7 @@ func RemoveRetentionCmd(vc *cmdutils.VerbCmd) {
 		"remove-retention",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveRetention(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func RemoveShadowCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for removing shadow topics from the shadow topics of a source " +
+		"topic, the messages of the source topic are no longer read from the removed shadow topics. " +
+		"The shadow topics themselves are not deleted."
+	desc.CommandPermission = "This command requires namespace admin permissions."
+	desc.CommandScope = "non-partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	remove := cmdutils.Example{
+		Desc:    "Remove the shadow topic (shadow-topic) from the source topic (source-topic)",
+		Command: "pulsarctl topics remove-shadow (source-topic) --shadow (shadow-topic)",
+	}
+	examples = append(examples, remove)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Remove shadow topic(s) [(shadow-topic)] from (source-topic) successfully",
+	}
+	notShadow := cmdutils.Output{
+		Desc: "the topic is not a shadow topic of the source topic",
+		Out:  "[✖]  the topic (shadow-topic) is not a shadow topic of the source topic",
+	}
+	out = append(out, successOut, ArgError, notShadow, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"remove-shadow",
+		"Remove shadow topics from a source topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var shadows []string
+
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doRemoveShadow(vc, shadows)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Remove Shadow", func(set *pflag.FlagSet) {
+		set.StringSliceVar(&shadows, "shadow", nil,
+			"The shadow topic to remove, can be specified multiple times")
+		_ = cobra.MarkFlagRequired(set, "shadow")
+	})
+}
+
+func doRemoveShadow(vc *cmdutils.VerbCmd, shadows []string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	source, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	remove := make([]string, 0, len(shadows))
+	for _, s := range shadows {
+		shadow, err := utils.GetTopicName(s)
+		if err != nil {
+			return err
+		}
+		remove = append(remove, shadow.String())
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	current, err := getShadowTopics(rc, *source)
+	if err != nil {
+		return err
+	}
+	left, err := removeShadowTopics(current, remove)
+	if err != nil {
+		return err
+	}
+	err = setShadowTopics(rc, *source, left)
+	if err == nil {
+		vc.Command.Printf("Remove shadow topic(s) %v from %s successfully\n", remove, source.String())
+	}
+	return err
+}
@@ -55,7 +55,7 @@ func RemoveSubscriptionDispatchRateCmd(vc *cmdutils.VerbCmd) {
 		"remove-subscription-dispatch-rate",
 	)
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doRemoveSubscriptionDispatchRate(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -0,0 +1,70 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestReplicationClusters(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-replication-clusters-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	setArgs := []string{"set-replication-clusters", topicName, "--clusters", "standalone"}
+	setOut, execErr, _, _ := TestTopicCommands(SetReplicationClustersCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal("Set replication clusters successfully for [" + topicName + "]\n"))
+
+	getArgs := []string{"get-replication-clusters", topicName}
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetReplicationClustersCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var clusters []string
+		err := json.Unmarshal(getOut.Bytes(), &clusters)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(clusters).Should(gomega.Equal([]string{"standalone"}))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-replication-clusters", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveReplicationClustersCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove replication clusters successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetReplicationClustersCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("null"))
+	}).Should(gomega.Succeed())
+}
+
+func TestSetReplicationClustersArgError(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	args := []string{"set-replication-clusters", "test-topic"}
+	_, _, _, err := TestTopicCommands(SetReplicationClustersCmd, args)
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	g.Expect(err.Error()).Should(gomega.Equal("required flag(s) \"clusters\" not set"))
+}
@@ -0,0 +1,202 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// ReplicationStatus is the status of the replication of a topic to a remote cluster
+type ReplicationStatus struct {
+	Topic                     string  `json:"topic"`
+	Cluster                   string  `json:"cluster"`
+	Connected                 bool    `json:"connected"`
+	ReplicationBacklog        int64   `json:"replicationBacklog"`
+	ReplicationDelayInSeconds int64   `json:"replicationDelayInSeconds"`
+	MsgRateIn                 float64 `json:"msgRateIn"`
+	MsgRateOut                float64 `json:"msgRateOut"`
+	MsgThroughputOut          float64 `json:"msgThroughputOut"`
+	MsgRateExpired            float64 `json:"msgRateExpired"`
+	OutboundConnectedSince    string  `json:"outboundConnectedSince"`
+}
+
+func ReplicationStatusCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for getting the replication status of a topic to each remote " +
+		"cluster, including whether the replicator is connected, the replication backlog, the replication " +
+		"delay and the rates. The replicators of a partitioned topic are aggregated unless --per-partition is set."
+	desc.CommandPermission = "This command requires tenant admin permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	status := cmdutils.Example{
+		Desc:    "Get the replication status of a topic (topic-name)",
+		Command: "pulsarctl topics replication-status (topic-name)",
+	}
+	partitionStatus := cmdutils.Example{
+		Desc:    "Get the replication status of each partition of a partitioned topic (topic-name)",
+		Command: "pulsarctl topics replication-status (topic-name) --per-partition",
+	}
+	examples = append(examples, status, partitionStatus)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+--------------------------------------+---------+-----------+---------+-----------+---------+----------+----------------+
+|                TOPIC                 | CLUSTER | CONNECTED | BACKLOG | DELAY (S) | RATE IN | RATE OUT | THROUGHPUT OUT |
++--------------------------------------+---------+-----------+---------+-----------+---------+----------+----------------+
+| persistent://public/default/my-topic | us-east | true      |     120 |         2 |    0.00 |    50.00 |       51200.00 |
++--------------------------------------+---------+-----------+---------+-----------+---------+----------+----------------+`,
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"replication-status",
+		"Get the replication status of a topic",
+		desc.ToString(),
+		desc.ExampleToString())
+
+	var perPartition bool
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doReplicationStatus(vc, perPartition)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Replication Status", func(set *pflag.FlagSet) {
+		set.BoolVar(&perPartition, "per-partition", false,
+			"Show the replication status of each partition of a partitioned topic")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doReplicationStatus(vc *cmdutils.VerbCmd, perPartition bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	stats, err := fetchReplicatorStats(*topic, perPartition)
+	if err != nil {
+		return err
+	}
+	status := replicationStatus(stats)
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(status).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Cluster", "Connected", "Backlog", "Delay (s)",
+				"Rate In", "Rate Out", "Throughput Out"})
+			for _, s := range status {
+				table.Append([]string{
+					s.Topic,
+					s.Cluster,
+					strconv.FormatBool(s.Connected),
+					strconv.FormatInt(s.ReplicationBacklog, 10),
+					strconv.FormatInt(s.ReplicationDelayInSeconds, 10),
+					strconv.FormatFloat(s.MsgRateIn, 'f', 2, 64),
+					strconv.FormatFloat(s.MsgRateOut, 'f', 2, 64),
+					strconv.FormatFloat(s.MsgThroughputOut, 'f', 2, 64),
+				})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+// fetchReplicatorStats fetches the replicator stats of a topic keyed by the topic name,
+// the stats of a partitioned topic are keyed by the partition names when perPartition is set
+func fetchReplicatorStats(topic utils.TopicName, perPartition bool) (map[string]map[string]utils.ReplicatorStats,
+	error) {
+	admin := cmdutils.NewPulsarClient()
+
+	partitions := 0
+	if topic.GetPartitionIndex() < 0 {
+		meta, err := admin.Topics().GetMetadata(topic)
+		if err != nil {
+			return nil, err
+		}
+		partitions = meta.Partitions
+	}
+
+	if partitions == 0 {
+		s, err := admin.Topics().GetStats(topic)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]map[string]utils.ReplicatorStats{topic.String(): s.Replication}, nil
+	}
+
+	s, err := admin.Topics().GetPartitionedStats(topic, perPartition)
+	if err != nil {
+		return nil, err
+	}
+	if !perPartition {
+		return map[string]map[string]utils.ReplicatorStats{topic.String(): s.Replication}, nil
+	}
+	stats := make(map[string]map[string]utils.ReplicatorStats, len(s.Partitions))
+	for name, p := range s.Partitions {
+		stats[name] = p.Replication
+	}
+	return stats, nil
+}
+
+func replicationStatus(stats map[string]map[string]utils.ReplicatorStats) []ReplicationStatus {
+	status := make([]ReplicationStatus, 0)
+	for topic, replication := range stats {
+		for cluster, r := range replication {
+			status = append(status, ReplicationStatus{
+				Topic:                     topic,
+				Cluster:                   cluster,
+				Connected:                 r.Connected,
+				ReplicationBacklog:        r.ReplicationBacklog,
+				ReplicationDelayInSeconds: r.ReplicationDelayInSeconds,
+				MsgRateIn:                 r.MsgRateIn,
+				MsgRateOut:                r.MsgRateOut,
+				MsgThroughputOut:          r.MsgThroughputOut,
+				MsgRateExpired:            r.MsgRateExpired,
+				OutboundConnectedSince:    r.OutboundConnectedSince,
+			})
+		}
+	}
+	sort.Slice(status, func(i, j int) bool {
+		if status[i].Topic != status[j].Topic {
+			return status[i].Topic < status[j].Topic
+		}
+		return status[i].Cluster < status[j].Cluster
+	})
+	return status
+}
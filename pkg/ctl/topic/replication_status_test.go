@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationStatus(t *testing.T) {
+	stats := map[string]map[string]utils.ReplicatorStats{
+		"persistent://public/default/t-partition-1": {
+			"us-west": {Connected: false, ReplicationBacklog: 10},
+			"us-east": {Connected: true, ReplicationBacklog: 20, ReplicationDelayInSeconds: 3, MsgRateOut: 5},
+		},
+		"persistent://public/default/t-partition-0": {
+			"us-east": {Connected: true},
+		},
+		"persistent://public/default/t-partition-2": {},
+	}
+
+	status := replicationStatus(stats)
+	assert.Len(t, status, 3)
+	assert.Equal(t, "persistent://public/default/t-partition-0", status[0].Topic)
+	assert.Equal(t, "us-east", status[1].Cluster)
+	assert.Equal(t, int64(20), status[1].ReplicationBacklog)
+	assert.Equal(t, int64(3), status[1].ReplicationDelayInSeconds)
+	assert.Equal(t, float64(5), status[1].MsgRateOut)
+	assert.Equal(t, "us-west", status[2].Cluster)
+	assert.False(t, status[2].Connected)
+
+	assert.NotNil(t, replicationStatus(nil))
+	assert.Len(t, replicationStatus(nil), 0)
+}
+
+func TestReplicationStatusArgsError(t *testing.T) {
+	args := []string{"replication-status"}
+	_, _, nameErr, _ := TestTopicCommands(ReplicationStatusCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestReplicationStatusNonReplicated(t *testing.T) {
+	args := []string{"create", "test-replication-status-topic", "0"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"replication-status", "test-replication-status-topic", "-o", "json"}
+	out, execErr, _, _ := TestTopicCommands(ReplicationStatusCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "[]", out.String())
+}
@@ -0,0 +1,92 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+var errAppliedEffective = errors.New("the --applied and --effective flags can not be used together")
+
+var schemaCompatibilityStrategies = []utils.SchemaCompatibilityStrategy{
+	utils.SchemaCompatibilityStrategyAlwaysIncompatible,
+	utils.SchemaCompatibilityStrategyAlwaysCompatible,
+	utils.SchemaCompatibilityStrategyBackward,
+	utils.SchemaCompatibilityStrategyForward,
+	utils.SchemaCompatibilityStrategyFull,
+	utils.SchemaCompatibilityStrategyBackwardTransitive,
+	utils.SchemaCompatibilityStrategyForwardTransitive,
+	utils.SchemaCompatibilityStrategyFullTransitive,
+}
+
+// parseSchemaCompatibilityStrategy parses the strategy case insensitively, UNDEFINED
+// is rejected because removing the policy is the way to fall back to the namespace
+func parseSchemaCompatibilityStrategy(s string) (utils.SchemaCompatibilityStrategy, error) {
+	for _, strategy := range schemaCompatibilityStrategies {
+		if strings.EqualFold(strategy.String(), strings.TrimSpace(s)) {
+			return strategy, nil
+		}
+	}
+	names := make([]string, 0, len(schemaCompatibilityStrategies))
+	for _, strategy := range schemaCompatibilityStrategies {
+		names = append(names, strategy.String())
+	}
+	return "", fmt.Errorf("invalid schema compatibility strategy '%s', valid strategies are %s",
+		s, strings.Join(names, ", "))
+}
+
+// effectiveTopicPolicy resolves a policy of the topic at the topic, namespace and broker level
+func effectiveTopicPolicy(topic *utils.TopicName, policy string) (*ctlutils.EffectivePolicy, error) {
+	policies, err := fetchEffectivePolicies(ctlutils.FindPolicyDefinitions(policy), topic)
+	if err != nil {
+		return nil, err
+	}
+	return &policies[0], nil
+}
+
+// writePolicyValue writes a topic level policy value, nil means the policy is not set
+func writePolicyValue(vc *cmdutils.VerbCmd, value interface{}) error {
+	oc := cmdutils.NewOutputContent().
+		WithObject(value).
+		WithTextFunc(func(w io.Writer) error {
+			if value == nil {
+				_, err := fmt.Fprint(w, "not set")
+				return err
+			}
+			_, err := fmt.Fprint(w, value)
+			return err
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func writeEffectivePolicy(vc *cmdutils.VerbCmd, p *ctlutils.EffectivePolicy) error {
+	oc := cmdutils.NewOutputContent().
+		WithObject(p).
+		WithTextFunc(func(w io.Writer) error {
+			return ctlutils.WriteEffectivePolicies(w, []ctlutils.EffectivePolicy{*p}, true)
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
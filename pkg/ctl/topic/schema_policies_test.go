@@ -0,0 +1,132 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func TestSchemaCompatibilityStrategy(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-schema-compatibility-strategy-topic"
+	args := []string{"create", topicName, "0"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	getArgs := []string{"get-schema-compatibility-strategy", topicName}
+	getOut, execErr, _, _ := TestTopicCommands(GetSchemaCompatibilityStrategyCmd, getArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(getOut.String()).Should(gomega.Equal("not set"))
+
+	setArgs := []string{"set-schema-compatibility-strategy", topicName, "-c", "full_transitive"}
+	setOut, execErr, _, _ := TestTopicCommands(SetSchemaCompatibilityStrategyCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set schema compatibility strategy FULL_TRANSITIVE successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSchemaCompatibilityStrategyCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("FULL_TRANSITIVE"))
+	}).Should(gomega.Succeed())
+
+	effectiveArgs := []string{"get-schema-compatibility-strategy", topicName, "--effective", "-o", "json"}
+	effectiveOut, execErr, _, _ := TestTopicCommands(GetSchemaCompatibilityStrategyCmd, effectiveArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	var p ctlutils.EffectivePolicy
+	g.Expect(json.Unmarshal(effectiveOut.Bytes(), &p)).Should(gomega.Succeed())
+	g.Expect(p.Value).Should(gomega.Equal("FULL_TRANSITIVE"))
+	g.Expect(p.Source).Should(gomega.Equal(ctlutils.PolicyLevelTopic))
+
+	removeArgs := []string{"remove-schema-compatibility-strategy", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveSchemaCompatibilityStrategyCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove schema compatibility strategy successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSchemaCompatibilityStrategyCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("not set"))
+	}).Should(gomega.Succeed())
+}
+
+func TestSchemaValidationEnforced(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-schema-validation-enforced-topic"
+	args := []string{"create", topicName, "0"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	setArgs := []string{"set-schema-validation-enforced", topicName}
+	setOut, execErr, _, _ := TestTopicCommands(SetSchemaValidationEnforcedCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set schema validation enforcement to true successfully for [" + topicName + "]\n"))
+
+	getArgs := []string{"get-schema-validation-enforced", topicName}
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSchemaValidationEnforcedCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("true"))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-schema-validation-enforced", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveSchemaValidationEnforcedCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove schema validation enforcement successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSchemaValidationEnforcedCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("not set"))
+	}).Should(gomega.Succeed())
+}
+
+func TestSchemaPoliciesArgError(t *testing.T) {
+	args := []string{"set-schema-compatibility-strategy", "test-topic", "-c", "LATEST"}
+	_, execErr, _, _ := TestTopicCommands(SetSchemaCompatibilityStrategyCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid schema compatibility strategy 'LATEST', valid strategies are "+
+		"ALWAYS_INCOMPATIBLE, ALWAYS_COMPATIBLE, BACKWARD, FORWARD, FULL, "+
+		"BACKWARD_TRANSITIVE, FORWARD_TRANSITIVE, FULL_TRANSITIVE", execErr.Error())
+
+	args = []string{"get-schema-validation-enforced", "test-topic", "--applied", "--effective"}
+	_, execErr, _, _ = TestTopicCommands(GetSchemaValidationEnforcedCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "the --applied and --effective flags can not be used together", execErr.Error())
+}
+
+func TestParseSchemaCompatibilityStrategy(t *testing.T) {
+	strategy, err := parseSchemaCompatibilityStrategy(" full_Transitive ")
+	assert.Nil(t, err)
+	assert.Equal(t, utils.SchemaCompatibilityStrategyFullTransitive, strategy)
+
+	_, err = parseSchemaCompatibilityStrategy("UNDEFINED")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,279 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	"github.com/streamnative/pulsarctl/pkg/pulsar/message"
+)
+
+type searchOptions struct {
+	key        string
+	properties []string
+	contains   string
+	jsonPath   string
+	from       string
+	to         string
+	limit      int
+	progress   time.Duration
+	serviceURL string
+}
+
+func SearchCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "This command is used for searching the messages of a topic by key, property or " +
+		"content. The partitions of a partitioned topic are scanned in parallel with readers, so no " +
+		"subscription is created. The payload is decoded with the topic schema before matching the content. " +
+		"The scan progress is reported on stderr."
+	desc.CommandPermission = "This command requires namespace consume permissions."
+	desc.CommandScope = "non-partitioned topic, a partition of a partitioned topic, partitioned topic"
+
+	var examples []cmdutils.Example
+	searchKey := cmdutils.Example{
+		Desc:    "Search the messages of a topic (topic-name) with a key",
+		Command: "pulsarctl topics search (topic-name) --key order-1234",
+	}
+	searchProperty := cmdutils.Example{
+		Desc:    "Search the messages of a topic (topic-name) published in the last day with a property",
+		Command: "pulsarctl topics search (topic-name) --property region=eu --from -24h",
+	}
+	searchContent := cmdutils.Example{
+		Desc:    "Search the first 10 messages of a topic (topic-name) with a payload containing a string",
+		Command: "pulsarctl topics search (topic-name) --contains 1234 --limit 10",
+	}
+	searchJSONPath := cmdutils.Example{
+		Desc:    "Search the messages of a topic (topic-name) with a JSON field equal to a value",
+		Command: "pulsarctl topics search (topic-name) --jsonpath '$.order.id == 1234'",
+	}
+	examples = append(examples, searchKey, searchProperty, searchContent, searchJSONPath)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: `+--------------------------------------------------+------------+----------------------+------------+
+|                      TOPIC                       | MESSAGE ID |     PUBLISH TIME     |    KEY     |
++--------------------------------------------------+------------+----------------------+------------+
+| persistent://public/default/my-topic-partition-0 | 10:2:0:-1  | 2020-01-01T00:00:00Z | order-1234 |
++--------------------------------------------------+------------+----------------------+------------+`,
+	}
+	noCriteriaError := cmdutils.Output{
+		Desc: "no search criteria is specified",
+		Out:  "[✖]  at least one of --key, --property, --contains and --jsonpath must be specified",
+	}
+	out = append(out, successOut, noCriteriaError, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"search",
+		"Search the messages of a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"search")
+
+	opts := searchOptions{}
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doSearch(vc, &opts)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Search", func(set *pflag.FlagSet) {
+		set.StringVar(&opts.key, "key", "", "Match the messages with the key")
+		set.StringSliceVar(&opts.properties, "property", nil,
+			"Match the messages with the property (key=value), can be specified multiple times")
+		set.StringVar(&opts.contains, "contains", "",
+			"Match the messages with a payload or a decoded value containing the string")
+		set.StringVar(&opts.jsonPath, "jsonpath", "",
+			"Match the messages with a JSON value selected by the path, optionally compared with == or !=")
+		set.StringVar(&opts.from, "from", "",
+			"The position to search from: earliest, a message id (ledgerID:entryID), an RFC3339 time, "+
+				"a unix timestamp in milliseconds or a relative time such as -1h. Defaults to earliest")
+		set.StringVar(&opts.to, "to", "",
+			"The position to search to, latest or the same forms as --from except earliest. "+
+				"Defaults to the last message "+
+				"published before the search started")
+		set.IntVar(&opts.limit, "limit", 100, "Stop searching after the number of matches, 0 means no limit")
+		set.DurationVar(&opts.progress, "progress-interval", 5*time.Second,
+			"The interval of reporting the scan progress, 0 disables the report")
+		cmdutils.AddServiceURLFlag(set, &opts.serviceURL)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSearch(vc *cmdutils.VerbCmd, opts *searchOptions) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	filter, err := newSearchFilter(opts)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	partitions, err := cmdutils.TopicPartitions(admin.Topics(), *topic)
+	if err != nil {
+		return err
+	}
+	r, err := parseRange(opts.from, opts.to, len(partitions))
+	if err != nil {
+		return err
+	}
+
+	client, err := cmdutils.NewDataClient(opts.serviceURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	decoder := message.NewDecoder(message.AdminSchemaFetcher(admin.Schemas(), topic.String()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var scanned int64
+	var mu sync.Mutex
+	var matches []*message.Message
+
+	if opts.progress > 0 {
+		ticker := time.NewTicker(opts.progress)
+		defer ticker.Stop()
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					mu.Lock()
+					found := len(matches)
+					mu.Unlock()
+					vc.Command.PrintErrf("Scanned %d messages, found %d matches\n",
+						atomic.LoadInt64(&scanned), found)
+				}
+			}
+		}()
+	}
+
+	errs := make([]error, len(partitions))
+	var wg sync.WaitGroup
+	for i, p := range partitions {
+		wg.Add(1)
+		go func(i int, p utils.TopicName) {
+			defer wg.Done()
+			errs[i] = message.ReadRange(ctx, client, p.String(), r, func(msg pulsar.Message) (bool, error) {
+				atomic.AddInt64(&scanned, 1)
+				m := message.FromPulsarMessage(msg)
+				mu.Lock()
+				// the decoder caches the codecs and is shared by the partitions
+				decoder.Decode(m)
+				mu.Unlock()
+				if !filter.Match(m) {
+					return true, nil
+				}
+
+				m.Payload, m.Value = nil, nil
+				mu.Lock()
+				defer mu.Unlock()
+				if opts.limit > 0 && len(matches) >= opts.limit {
+					return false, nil
+				}
+				matches = append(matches, m)
+				if opts.limit > 0 && len(matches) >= opts.limit {
+					cancel()
+					return false, nil
+				}
+				return true, nil
+			})
+		}(i, p)
+	}
+	wg.Wait()
+	cancel()
+
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].PublishTime.Before(matches[j].PublishTime)
+	})
+	vc.Command.PrintErrf("Scanned %d messages, found %d matches\n", scanned, len(matches))
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(matches).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Topic", "Message ID", "Publish Time", "Key"})
+			for _, m := range matches {
+				table.Append([]string{m.Topic, m.MessageID, m.PublishTime.Format(time.RFC3339), m.Key})
+			}
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func newSearchFilter(opts *searchOptions) (*message.Filter, error) {
+	if opts.key == "" && len(opts.properties) == 0 && opts.contains == "" && opts.jsonPath == "" {
+		return nil, errors.New("at least one of --key, --property, --contains and --jsonpath must be specified")
+	}
+
+	f := &message.Filter{
+		Key:        opts.key,
+		Contains:   opts.contains,
+		Properties: make(map[string]string, len(opts.properties)),
+	}
+	for _, p := range opts.properties {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid property '%s', it should be in the format of key=value", p)
+		}
+		f.Properties[kv[0]] = kv[1]
+	}
+	if opts.jsonPath != "" {
+		path, err := message.ParseJSONPath(opts.jsonPath)
+		if err != nil {
+			return nil, err
+		}
+		f.JSONPath = path
+	}
+	return f, nil
+}
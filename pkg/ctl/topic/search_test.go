@@ -0,0 +1,67 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchArgsError(t *testing.T) {
+	args := []string{"search"}
+	_, _, nameErr, _ := TestTopicCommands(SearchCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the topic name is not specified or the topic name is specified more than one", nameErr.Error())
+}
+
+func TestSearchNoCriteriaError(t *testing.T) {
+	args := []string{"search", "test-search-topic"}
+	_, execErr, _, _ := TestTopicCommands(SearchCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "at least one of --key, --property, --contains and --jsonpath must be specified", execErr.Error())
+}
+
+func TestSearchInvalidPropertyError(t *testing.T) {
+	args := []string{"search", "test-search-topic", "--property", "region"}
+	_, execErr, _, _ := TestTopicCommands(SearchCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid property 'region', it should be in the format of key=value", execErr.Error())
+}
+
+func TestSearch(t *testing.T) {
+	topic := "persistent://public/default/test-search-topic"
+
+	args := []string{"create", topic, "2"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	in := t.TempDir() + "/in.ndjson"
+	assert.Nil(t, os.WriteFile(in,
+		[]byte(`{"key":"order-1","value":{"id":1}}`+"\n"+`{"key":"order-2","value":{"id":2}}`+"\n"), 0600))
+	args = []string{"import", topic, "-f", in}
+	_, execErr, _, _ = TestTopicCommands(ImportCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"search", topic, "--jsonpath", "$.id == 2", "-o", "json"}
+	out, execErr, _, _ := TestTopicCommands(SearchCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), `"key": "order-2"`)
+	assert.NotContains(t, out.String(), `"key": "order-1"`)
+}
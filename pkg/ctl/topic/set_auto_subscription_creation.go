@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetAutoSubscriptionCreationCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set auto subscription creation override for a topic"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	enable := cmdutils.Example{
+		Desc:    "Enable auto subscription creation for a topic",
+		Command: "pulsarctl topics set-auto-subscription-creation topic --enable",
+	}
+	disable := cmdutils.Example{
+		Desc:    "Disable auto subscription creation for a topic",
+		Command: "pulsarctl topics set-auto-subscription-creation topic",
+	}
+	examples = append(examples, enable, disable)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set auto subscription creation override successfully for [topic]",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-auto-subscription-creation",
+		"Set auto subscription creation override for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-auto-subscription-creation",
+	)
+
+	override := utils.AutoSubscriptionCreationOverride{}
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetAutoSubscriptionCreation(vc, override)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("AutoSubscriptionCreation", func(set *pflag.FlagSet) {
+		set.BoolVarP(
+			&override.AllowAutoSubscriptionCreation,
+			"enable",
+			"e",
+			false,
+			"Enable auto subscription creation, it is disabled when omitted")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetAutoSubscriptionCreation(vc *cmdutils.VerbCmd, override utils.AutoSubscriptionCreationOverride) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().SetAutoSubscriptionCreation(*topic, override)
+	if err == nil {
+		vc.Command.Printf("Set auto subscription creation override successfully for [%s]\n", topic.String())
+	}
+	return err
+}
@@ -102,7 +102,7 @@ func SetBacklogQuotaCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetBacklogQuota(vc, backlogQuota)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
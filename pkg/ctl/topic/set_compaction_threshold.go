@@ -66,7 +66,7 @@ func SetCompactionThresholdCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetCompactionThreshold(vc, threshold)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
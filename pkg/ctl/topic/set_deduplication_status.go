@@ -56,7 +56,7 @@ func SetDeduplicationStatusCmd(vc *cmdutils.VerbCmd) {
 		"set-deduplication",
 	)
 	var enable, disable bool
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetDeduplicationStatus(vc, enable, disable)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
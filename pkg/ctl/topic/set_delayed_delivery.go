@@ -57,7 +57,7 @@ func SetDelayedDeliveryCmd(vc *cmdutils.VerbCmd) {
 		"set-delayed-delivery",
 	)
 	delayedDeliveryData := &utils.DelayedDeliveryCmdData{}
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetDelayedDelivery(vc, delayedDeliveryData)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
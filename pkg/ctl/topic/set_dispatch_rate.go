@@ -56,7 +56,7 @@ func SetDispatchRateCmd(vc *cmdutils.VerbCmd) {
 		"set-dispatch-rate",
 	)
 	dispatchRateData := &utils.DispatchRateData{}
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetDispatchRate(vc, dispatchRateData)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
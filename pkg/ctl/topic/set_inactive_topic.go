@@ -82,7 +82,7 @@ func SetInactiveTopicCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetInactiveTopic(vc, args)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
@@ -55,7 +55,7 @@ func SetMaxConsumersCmd(vc *cmdutils.VerbCmd) {
 		"set-max-consumers",
 	)
 	var maxConsumers int
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetMaxConsumers(vc, maxConsumers)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
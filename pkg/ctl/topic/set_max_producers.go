@@ -55,7 +55,7 @@ func SetMaxProducersCmd(vc *cmdutils.VerbCmd) {
 		"set-max-producers",
 	)
 	var maxProducers int
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetMaxProducers(vc, maxProducers)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
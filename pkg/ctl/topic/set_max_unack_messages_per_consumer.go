@@ -55,7 +55,7 @@ func SetMaxUnackMessagesPerConsumerCmd(vc *cmdutils.VerbCmd) {
 		"set-max-unacked-messages-per-consumer",
 	)
 	var maxUnackedNum int
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetMaxUnackMessagesPerConsumer(vc, maxUnackedNum)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
@@ -55,7 +55,7 @@ func SetMaxUnackMessagesPerSubscriptionCmd(vc *cmdutils.VerbCmd) {
 		"set-max-unacked-messages-per-subscription",
 	)
 	var maxUnackedNum int
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetMaxUnackMessagesPerSubscription(vc, maxUnackedNum)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
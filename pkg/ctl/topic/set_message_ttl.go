@@ -55,7 +55,7 @@ func SetMessageTTLCmd(vc *cmdutils.VerbCmd) {
 		"set-message-ttl",
 	)
 	var messageTTL int
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetMessageTTL(vc, messageTTL)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
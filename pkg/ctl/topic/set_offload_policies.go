@@ -0,0 +1,105 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+	ctlutils "github.com/streamnative/pulsarctl/pkg/ctl/utils"
+)
+
+func SetOffloadPoliciesCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set the offload policies for a topic, the topic level policies " +
+		"take precedence over the namespace level policies"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	s3 := cmdutils.Example{
+		Desc: "Offload a topic to S3 once it holds more than 10 GB",
+		Command: "pulsarctl topics set-offload-policies topic --driver aws-s3 --bucket bucket " +
+			"--region us-west-2 --threshold 10g",
+	}
+	gcs := cmdutils.Example{
+		Desc: "Offload a topic to GCS after one day and read from the tiered storage first",
+		Command: "pulsarctl topics set-offload-policies topic --driver google-cloud-storage " +
+			"--bucket bucket --region europe-west3 --threshold-seconds 1d --read-priority tiered-storage-first",
+	}
+	examples = append(examples, s3, gcs)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set offload policies successfully for [topic]",
+	}
+	invalidDriver := cmdutils.Output{
+		Desc: "the offload driver is invalid",
+		Out: "[✖]  invalid offload driver '<driver>', valid drivers are " +
+			"S3, aws-s3, google-cloud-storage, filesystem, azureblob, aliyun-oss",
+	}
+	out = append(out, successOut, ArgError, invalidDriver)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-offload-policies",
+		"Set the offload policies for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-offload-policies",
+	)
+
+	flags := &ctlutils.OffloadPolicyFlags{}
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetOffloadPolicies(vc, flags)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("OffloadPolicies", func(set *pflag.FlagSet) {
+		flags.AddTo(set)
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetOffloadPolicies(vc *cmdutils.VerbCmd, flags *ctlutils.OffloadPolicyFlags) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	policies, err := flags.Policies()
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.Post(rc.Endpoint("", topic.GetRestPath(), "offloadPolicies"), policies)
+	if err == nil {
+		vc.Command.Printf("Set offload policies successfully for [%s]\n", topic.String())
+	}
+	return err
+}
@@ -55,7 +55,7 @@ func SetPersistenceCmd(vc *cmdutils.VerbCmd) {
 		"set-persistence",
 	)
 	persistenceData := &utils.PersistenceData{}
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetPersistence(vc, persistenceData)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
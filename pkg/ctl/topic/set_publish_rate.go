@@ -57,7 +57,7 @@ func SetPublishRateCmd(vc *cmdutils.VerbCmd) {
 		"set-publish-rate",
 	)
 	publishRateData := &utils.PublishRateData{}
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetPublishRate(vc, publishRateData)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
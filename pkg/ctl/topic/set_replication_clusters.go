@@ -0,0 +1,99 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetReplicationClustersCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set the replication clusters for a topic, the topic level clusters " +
+		"take precedence over the namespace level clusters"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Replicate a topic to the clusters us-west and us-east",
+		Command: "pulsarctl topics set-replication-clusters topic --clusters us-west,us-east",
+	}
+	examples = append(examples, msg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set replication clusters successfully for [topic]",
+	}
+	clusterNotAllowed := cmdutils.Output{
+		Desc: "the cluster is not allowed for the tenant of the topic",
+		Out:  "[✖]  code: 403 reason: Cluster name is not in the list of allowed clusters list for tenant [public]",
+	}
+	out = append(out, successOut, ArgError, clusterNotAllowed)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-replication-clusters",
+		"Set the replication clusters for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-replication-clusters",
+	)
+
+	var clusters []string
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetReplicationClusters(vc, clusters)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("ReplicationClusters", func(set *pflag.FlagSet) {
+		set.StringSliceVarP(
+			&clusters,
+			"clusters",
+			"c",
+			nil,
+			"Replication clusters of the topic, separated by comma")
+		_ = cobra.MarkFlagRequired(set, "clusters")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetReplicationClusters(vc *cmdutils.VerbCmd, clusters []string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().SetReplicationClusters(*topic, clusters)
+	if err == nil {
+		vc.Command.Printf("Set replication clusters successfully for [%s]\n", topic.String())
+	}
+	return err
+}
@@ -94,7 +94,7 @@ func SetRetentionCmd(vc *cmdutils.VerbCmd) {
 	})
 	vc.EnableOutputFlagSet()
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetRetention(vc, timeStr, sizeStr)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
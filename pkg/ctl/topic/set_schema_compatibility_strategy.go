@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetSchemaCompatibilityStrategyCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set the schema compatibility strategy for a topic, " +
+		"it overrides the strategy of the namespace and the broker"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Set the schema compatibility strategy for a topic",
+		Command: "pulsarctl topics set-schema-compatibility-strategy topic --compatibility FULL_TRANSITIVE",
+	}
+	examples = append(examples, msg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set schema compatibility strategy FULL_TRANSITIVE successfully for [topic]",
+	}
+	invalidOut := cmdutils.Output{
+		Desc: "the schema compatibility strategy is invalid",
+		Out: "[✖]  invalid schema compatibility strategy '<strategy>', valid strategies are " +
+			"ALWAYS_INCOMPATIBLE, ALWAYS_COMPATIBLE, BACKWARD, FORWARD, FULL, " +
+			"BACKWARD_TRANSITIVE, FORWARD_TRANSITIVE, FULL_TRANSITIVE",
+	}
+	out = append(out, successOut, invalidOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-schema-compatibility-strategy",
+		"Set the schema compatibility strategy for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-schema-compatibility-strategy",
+	)
+
+	var strategy string
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetSchemaCompatibilityStrategy(vc, strategy)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("SchemaCompatibilityStrategy", func(set *pflag.FlagSet) {
+		set.StringVarP(&strategy, "compatibility", "c", "",
+			"Schema compatibility strategy: ALWAYS_INCOMPATIBLE, ALWAYS_COMPATIBLE, BACKWARD, FORWARD, FULL, "+
+				"BACKWARD_TRANSITIVE, FORWARD_TRANSITIVE, FULL_TRANSITIVE")
+		_ = cobra.MarkFlagRequired(set, "compatibility")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetSchemaCompatibilityStrategy(vc *cmdutils.VerbCmd, s string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	strategy, err := parseSchemaCompatibilityStrategy(s)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().SetSchemaCompatibilityStrategy(*topic, strategy)
+	if err == nil {
+		vc.Command.Printf("Set schema compatibility strategy %s successfully for [%s]\n",
+			strategy.String(), topic.String())
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetSchemaValidationEnforcedCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set the schema validation enforcement for a topic, " +
+		"it overrides the policy of the namespace and the broker"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	enableMsg := cmdutils.Example{
+		Desc:    "Enforce the schema validation for a topic",
+		Command: "pulsarctl topics set-schema-validation-enforced topic",
+	}
+	disableMsg := cmdutils.Example{
+		Desc:    "Disable the schema validation enforcement for a topic",
+		Command: "pulsarctl topics set-schema-validation-enforced topic --disable",
+	}
+	examples = append(examples, enableMsg, disableMsg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set schema validation enforcement to true successfully for [topic]",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-schema-validation-enforced",
+		"Set the schema validation enforcement for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-schema-validation-enforced",
+	)
+
+	var disable bool
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetSchemaValidationEnforced(vc, !disable)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("SchemaValidationEnforced", func(set *pflag.FlagSet) {
+		set.BoolVarP(&disable, "disable", "d", false,
+			"Disable the schema validation enforcement")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetSchemaValidationEnforced(vc *cmdutils.VerbCmd, enforced bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().SetSchemaValidationEnforced(*topic, enforced)
+	if err == nil {
+		vc.Command.Printf("Set schema validation enforcement to %t successfully for [%s]\n",
+			enforced, topic.String())
+	}
+	return err
+}
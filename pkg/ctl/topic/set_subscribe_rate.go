@@ -0,0 +1,97 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetSubscribeRateCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set subscribe rate per consumer for a topic"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Set subscribe rate per consumer for a topic",
+		Command: "pulsarctl topics set-subscribe-rate topic --subscribe-rate 10 --subscribe-rate-period 30",
+	}
+	examples = append(examples, msg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set subscribe rate per consumer successfully for [topic]",
+	}
+	out = append(out, successOut, ArgError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-subscribe-rate",
+		"Set subscribe rate per consumer for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-subscribe-rate",
+	)
+
+	rate := utils.NewSubscribeRate()
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetSubscribeRate(vc, rate)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("SubscribeRate", func(set *pflag.FlagSet) {
+		set.IntVarP(
+			&rate.SubscribeThrottlingRatePerConsumer,
+			"subscribe-rate",
+			"m",
+			-1,
+			"subscribe-rate per consumer (defaults to -1 and overwrites the existing value when omitted)")
+		set.IntVarP(
+			&rate.RatePeriodInSecond,
+			"subscribe-rate-period",
+			"p",
+			30,
+			"subscribe-rate-period in second type (defaults to 30 seconds and overwrites the existing value when omitted)")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetSubscribeRate(vc *cmdutils.VerbCmd, rate *utils.SubscribeRate) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().SetSubscribeRate(*topic, *rate)
+	if err == nil {
+		vc.Command.Printf("Set subscribe rate per consumer successfully for [%s]\n", topic.String())
+	}
+	return err
+}
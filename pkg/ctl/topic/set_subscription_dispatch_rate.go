@@ -57,7 +57,7 @@ func SetSubscriptionDispatchRateCmd(vc *cmdutils.VerbCmd) {
 		"set-subscription-dispatch-rate",
 	)
 	dispatchRateData := &utils.DispatchRateData{}
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doSetSubscriptionDispatchRate(vc, dispatchRateData)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
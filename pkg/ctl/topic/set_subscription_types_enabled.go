@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func SetSubscriptionTypesEnabledCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Set the enabled subscription types for a topic, " +
+		"the valid types are Exclusive, Shared, Failover and Key_Shared"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Only allow Key_Shared subscriptions on a topic",
+		Command: "pulsarctl topics set-subscription-types-enabled topic -t Key_Shared",
+	}
+	multi := cmdutils.Example{
+		Desc:    "Allow Failover and Exclusive subscriptions on a topic",
+		Command: "pulsarctl topics set-subscription-types-enabled topic -t Failover,Exclusive",
+	}
+	examples = append(examples, msg, multi)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Set enabled subscription types successfully for [topic]",
+	}
+	invalidType := cmdutils.Output{
+		Desc: "the subscription type is invalid",
+		Out:  "[✖]  invalid subscription type '<type>', valid types are Exclusive, Shared, Failover, Key_Shared",
+	}
+	out = append(out, successOut, ArgError, invalidType)
+	out = append(out, TopicNameErrors...)
+	out = append(out, TopicLevelPolicyNotEnabledError)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"set-subscription-types-enabled",
+		"Set the enabled subscription types for a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"set-subscription-types-enabled",
+	)
+
+	var types []string
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
+		return doSetSubscriptionTypesEnabled(vc, types)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("SubscriptionTypesEnabled", func(set *pflag.FlagSet) {
+		set.StringSliceVarP(
+			&types,
+			"types",
+			"t",
+			nil,
+			"Subscription types enabled for the topic, separated by comma")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSetSubscriptionTypesEnabled(vc *cmdutils.VerbCmd, types []string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	enabled, err := parseSubscriptionTypes(types)
+	if err != nil {
+		return err
+	}
+
+	rc := cmdutils.NewPulsarRestClient()
+	err = rc.Post(rc.Endpoint("", topic.GetRestPath(), "subscriptionTypesEnabled"), enabled)
+	if err == nil {
+		vc.Command.Printf("Set enabled subscription types successfully for [%s]\n", topic.String())
+	}
+	return err
+}
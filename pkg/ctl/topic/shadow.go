@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// shadowSourceProperty is the topic property which points a shadow topic to its source topic
+const shadowSourceProperty = "PULSAR.SHADOW_SOURCE"
+
+func getShadowTopics(rc *cmdutils.RestClient, source utils.TopicName) ([]string, error) {
+	shadows := make([]string, 0)
+	err := rc.Get(rc.Endpoint("", source.GetRestPath(), "shadowTopics"), &shadows)
+	if err != nil {
+		return nil, err
+	}
+	return shadows, nil
+}
+
+// setShadowTopics replaces the shadow topics of the source topic, the policy is
+// removed when there is no shadow topic left
+func setShadowTopics(rc *cmdutils.RestClient, source utils.TopicName, shadows []string) error {
+	endpoint := rc.Endpoint("", source.GetRestPath(), "shadowTopics")
+	if len(shadows) == 0 {
+		return rc.Delete(endpoint)
+	}
+	return rc.Put(endpoint, shadows)
+}
+
+func addShadowTopic(shadows []string, shadow string) []string {
+	for _, s := range shadows {
+		if s == shadow {
+			return shadows
+		}
+	}
+	return append(shadows, shadow)
+}
+
+func removeShadowTopics(shadows []string, remove []string) ([]string, error) {
+	left := make([]string, 0, len(shadows))
+	removed := make(map[string]bool, len(remove))
+	for _, s := range shadows {
+		found := false
+		for _, r := range remove {
+			if s == r {
+				found = true
+				removed[r] = true
+			}
+		}
+		if !found {
+			left = append(left, s)
+		}
+	}
+	for _, r := range remove {
+		if !removed[r] {
+			return nil, errors.Errorf("the topic %s is not a shadow topic of the source topic", r)
+		}
+	}
+	return left, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShadowTopicsList(t *testing.T) {
+	shadows := addShadowTopic([]string{}, "persistent://public/default/s1")
+	shadows = addShadowTopic(shadows, "persistent://public/default/s2")
+	shadows = addShadowTopic(shadows, "persistent://public/default/s1")
+	assert.Equal(t, []string{"persistent://public/default/s1", "persistent://public/default/s2"}, shadows)
+
+	left, err := removeShadowTopics(shadows, []string{"persistent://public/default/s1"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"persistent://public/default/s2"}, left)
+
+	_, err = removeShadowTopics(shadows, []string{"persistent://public/default/s3"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "the topic persistent://public/default/s3 is not a shadow topic of the source topic",
+		err.Error())
+}
+
+func TestShadowTopics(t *testing.T) {
+	source := "persistent://public/default/test-shadow-source"
+	shadow := "persistent://public/default/test-shadow-source-shadow"
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, []string{"create", source, "2"})
+	assert.Nil(t, execErr)
+
+	args := []string{"create-shadow", shadow, "--source", source}
+	out, execErr, _, _ := TestTopicCommands(CreateShadowCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Create shadow topic "+shadow+" of "+source+" successfully\n", out.String())
+
+	args = []string{"list-shadows", source, "-o", "json"}
+	out, execErr, _, _ = TestTopicCommands(ListShadowsCmd, args)
+	assert.Nil(t, execErr)
+	var shadows []string
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &shadows))
+	assert.Equal(t, []string{shadow}, shadows)
+
+	out, execErr, _, _ = TestTopicCommands(GetPropertiesCmd, []string{"get-properties", shadow})
+	assert.Nil(t, execErr)
+	var properties map[string]string
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &properties))
+	assert.Equal(t, source, properties[shadowSourceProperty])
+
+	args = []string{"remove-shadow", source, "--shadow", shadow}
+	out, execErr, _, _ = TestTopicCommands(RemoveShadowCmd, args)
+	assert.Nil(t, execErr)
+	assert.Equal(t, "Remove shadow topic(s) ["+shadow+"] from "+source+" successfully\n", out.String())
+
+	out, execErr, _, _ = TestTopicCommands(ListShadowsCmd, []string{"list-shadows", source})
+	assert.Nil(t, execErr)
+	assert.Equal(t, "", out.String())
+}
+
+func TestCreateShadowArgsError(t *testing.T) {
+	args := []string{"create-shadow", "test-shadow"}
+	_, _, _, err := TestTopicCommands(CreateShadowCmd, args)
+	assert.NotNil(t, err)
+	assert.Equal(t, "required flag(s) \"source\" not set", err.Error())
+}
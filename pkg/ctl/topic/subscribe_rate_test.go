@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/onsi/gomega"
+)
+
+func TestSubscribeRate(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-subscribe-rate-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	getArgs := []string{"get-subscribe-rate", topicName}
+	getOut, execErr, _, _ := TestTopicCommands(GetSubscribeRateCmd, getArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(getOut.String()).Should(gomega.Equal("null"))
+
+	setArgs := []string{"set-subscribe-rate", topicName, "--subscribe-rate", "10", "--subscribe-rate-period", "20"}
+	setOut, execErr, _, _ := TestTopicCommands(SetSubscribeRateCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set subscribe rate per consumer successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSubscribeRateCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var rate utils.SubscribeRate
+		err := json.Unmarshal(getOut.Bytes(), &rate)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(rate.SubscribeThrottlingRatePerConsumer).Should(gomega.Equal(10))
+		g.Expect(rate.RatePeriodInSecond).Should(gomega.Equal(20))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-subscribe-rate", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveSubscribeRateCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove subscribe rate per consumer successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSubscribeRateCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		g.Expect(getOut.String()).Should(gomega.Equal("null"))
+	}).Should(gomega.Succeed())
+}
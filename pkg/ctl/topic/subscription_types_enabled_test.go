@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionTypesEnabled(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	topicName := "persistent://public/default/test-subscription-types-enabled-topic"
+	args := []string{"create", topicName, "1"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	g.Expect(execErr).Should(gomega.BeNil())
+
+	setArgs := []string{"set-subscription-types-enabled", topicName, "-t", "key_shared,Failover"}
+	setOut, execErr, _, _ := TestTopicCommands(SetSubscriptionTypesEnabledCmd, setArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(setOut.String()).Should(gomega.Equal(
+		"Set enabled subscription types successfully for [" + topicName + "]\n"))
+
+	getArgs := []string{"get-subscription-types-enabled", topicName}
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSubscriptionTypesEnabledCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var types []string
+		err := json.Unmarshal(getOut.Bytes(), &types)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(types).Should(gomega.ConsistOf("Key_Shared", "Failover"))
+	}).Should(gomega.Succeed())
+
+	removeArgs := []string{"remove-subscription-types-enabled", topicName}
+	removeOut, execErr, _, _ := TestTopicCommands(RemoveSubscriptionTypesEnabledCmd, removeArgs)
+	g.Expect(execErr).Should(gomega.BeNil())
+	g.Expect(removeOut.String()).Should(gomega.Equal(
+		"Remove enabled subscription types successfully for [" + topicName + "]\n"))
+
+	g.Eventually(func(g gomega.Gomega) {
+		getOut, execErr, _, _ := TestTopicCommands(GetSubscriptionTypesEnabledCmd, getArgs)
+		g.Expect(execErr).Should(gomega.BeNil())
+		var types []string
+		err := json.Unmarshal(getOut.Bytes(), &types)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(types).Should(gomega.BeEmpty())
+	}).Should(gomega.Succeed())
+}
+
+func TestSetSubscriptionTypesEnabledArgError(t *testing.T) {
+	args := []string{"set-subscription-types-enabled", "test-topic", "-t", "Round_Robin"}
+	_, execErr, _, _ := TestTopicCommands(SetSubscriptionTypesEnabledCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid subscription type 'Round_Robin', valid types are "+
+		"Exclusive, Shared, Failover, Key_Shared", execErr.Error())
+
+	args = []string{"set-subscription-types-enabled", "test-topic"}
+	_, execErr, _, _ = TestTopicCommands(SetSubscriptionTypesEnabledCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "at least one subscription type must be specified", execErr.Error())
+}
+
+func TestParseSubscriptionTypes(t *testing.T) {
+	types, err := parseSubscriptionTypes([]string{"exclusive", " SHARED ", "Key_Shared"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Exclusive", "Shared", "Key_Shared"}, types)
+}
@@ -68,7 +68,7 @@ func TerminateCmd(vc *cmdutils.VerbCmd) {
 
 	var partition int
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doTerminate(vc, partition)
 	}, "the topic name is not specified or the topic name is specified more than one")
 
@@ -0,0 +1,52 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopArgsError(t *testing.T) {
+	args := []string{"top"}
+	_, _, nameErr, _ := TestTopicCommands(TopCmd, args)
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the namespace name is not specified or the namespace name is specified more than one",
+		nameErr.Error())
+}
+
+func TestTopInvalidSortKey(t *testing.T) {
+	args := []string{"top", "public/default", "--sort-by", "unknown"}
+	_, execErr, _, _ := TestTopicCommands(TopCmd, args)
+	assert.NotNil(t, execErr)
+	assert.Contains(t, execErr.Error(), "invalid sort key 'unknown'")
+}
+
+func TestTop(t *testing.T) {
+	topic := "persistent://public/default/test-top-topic"
+	args := []string{"create", topic, "2"}
+	_, execErr, _, _ := TestTopicCommands(CreateTopicCmd, args)
+	assert.Nil(t, execErr)
+
+	args = []string{"top", "public/default", "--sort-by", "name", "--limit", "0"}
+	out, execErr, _, _ := TestTopicCommands(TopCmd, args)
+	assert.Nil(t, execErr)
+	assert.Contains(t, out.String(), topic)
+	assert.NotContains(t, out.String(), topic+"-partition-0")
+}
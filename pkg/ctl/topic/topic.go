@@ -96,6 +96,50 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 		GetInactiveTopicCmd,
 		SetInactiveTopicCmd,
 		RemoveInactiveTopicCmd,
+		GetSubscriptionTypesEnabledCmd,
+		SetSubscriptionTypesEnabledCmd,
+		RemoveSubscriptionTypesEnabledCmd,
+		GetMaxSubscriptionsPerTopicCmd,
+		SetMaxSubscriptionsPerTopicCmd,
+		RemoveMaxSubscriptionsPerTopicCmd,
+		GetMaxConsumersPerSubscriptionCmd,
+		SetMaxConsumersPerSubscriptionCmd,
+		RemoveMaxConsumersPerSubscriptionCmd,
+		GetAutoSubscriptionCreationCmd,
+		SetAutoSubscriptionCreationCmd,
+		RemoveAutoSubscriptionCreationCmd,
+		GetSubscribeRateCmd,
+		SetSubscribeRateCmd,
+		RemoveSubscribeRateCmd,
+		GetOffloadPoliciesCmd,
+		SetOffloadPoliciesCmd,
+		RemoveOffloadPoliciesCmd,
+		GetReplicationClustersCmd,
+		SetReplicationClustersCmd,
+		RemoveReplicationClustersCmd,
+		GetReplicatorDispatchRateCmd,
+		SetReplicatorDispatchRateCmd,
+		RemoveReplicatorDispatchRateCmd,
+		ReplicationStatusCmd,
+		GetPropertiesCmd,
+		UpdatePropertiesCmd,
+		RemovePropertyCmd,
+		CreateShadowCmd,
+		ListShadowsCmd,
+		RemoveShadowCmd,
+		GetSchemaCompatibilityStrategyCmd,
+		SetSchemaCompatibilityStrategyCmd,
+		RemoveSchemaCompatibilityStrategyCmd,
+		GetSchemaValidationEnforcedCmd,
+		SetSchemaValidationEnforcedCmd,
+		RemoveSchemaValidationEnforcedCmd,
+		GetPoliciesCmd,
+		ExportCmd,
+		ImportCmd,
+		SearchCmd,
+		TopCmd,
+		ConsumersCmd,
+		ProducersCmd,
 	}
 
 	cmdutils.AddVerbCmds(flagGrouping, resourceCmd, commands...)
@@ -53,7 +53,7 @@ func UnloadCmd(vc *cmdutils.VerbCmd) {
 		desc.ToString(),
 		desc.ExampleToString())
 
-	vc.SetRunFuncWithNameArg(func() error {
+	setRunFuncWithTopicSelector(vc, func(vc *cmdutils.VerbCmd) error {
 		return doUnloadCmd(vc)
 	}, "the topic name is not specified or the topic name is specified more than one")
 }
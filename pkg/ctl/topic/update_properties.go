@@ -0,0 +1,90 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package topic
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func UpdatePropertiesCmd(vc *cmdutils.VerbCmd) {
+	desc := cmdutils.LongDescription{}
+	desc.CommandUsedFor = "Update the properties of a topic, the given properties are added to " +
+		"or overwrite the existing properties"
+	desc.CommandPermission = "This command requires tenant admin permissions."
+
+	var examples []cmdutils.Example
+	msg := cmdutils.Example{
+		Desc:    "Label a topic with the owning team and the cost center",
+		Command: "pulsarctl topics update-properties topic --property team=payments --property cost-center=cc-42",
+	}
+	examples = append(examples, msg)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Update properties successfully for [topic]",
+	}
+	out = append(out, successOut, ArgError, TopicNotFoundError)
+	out = append(out, TopicNameErrors...)
+	out = append(out, NamespaceErrors...)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"update-properties",
+		"Update the properties of a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"update-properties",
+	)
+
+	var properties map[string]string
+	vc.SetRunFuncWithNameArg(func() error {
+		return doUpdateProperties(vc, properties)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Properties", func(set *pflag.FlagSet) {
+		set.StringToStringVarP(&properties, "property", "p", nil,
+			"Properties of the topic (key=value), can be specified multiple times")
+		_ = cobra.MarkFlagRequired(set, "property")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doUpdateProperties(vc *cmdutils.VerbCmd, properties map[string]string) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	topic, err := utils.GetTopicName(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	admin := cmdutils.NewPulsarClient()
+	err = admin.Topics().UpdateProperties(*topic, properties)
+	if err == nil {
+		vc.Command.Printf("Update properties successfully for [%s]\n", topic.String())
+	}
+	return err
+}
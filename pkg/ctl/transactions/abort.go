@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"strconv"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func abortCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Abort a transaction, the messages produced in the transaction are discarded " +
+		"and the acknowledgements made in the transaction are rolled back"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	abort := cmdutils.Example{
+		Desc:    "Abort a transaction",
+		Command: "pulsarctl transactions abort \"(0,3)\"",
+	}
+	examples = append(examples, abort)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out:  "Abort transaction (0,3) successfully",
+	}
+	out = append(out, successOut, txnIDArgError, invalidTxnIDError, txnNotFoundError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"abort",
+		"Abort a transaction",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"abort")
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doAbort(vc)
+	}, "the transaction id is not specified or the transaction id is specified more than one")
+}
+
+func doAbort(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	id, err := parseTxnID(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := newTransactionsClient()
+	err = rc.Post(txnEndpoint(rc, "abortTransaction",
+		strconv.FormatInt(id.MostSigBits, 10), strconv.FormatInt(id.LeastSigBits, 10)), nil)
+	if err == nil {
+		vc.Command.Printf("Abort transaction %s successfully\n", id.String())
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"strconv"
+
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+var topicArgError = cmdutils.Output{
+	Desc: "the topic name is not specified or the topic name is specified more than one",
+	Out:  "[✖]  the topic name is not specified or the topic name is specified more than one",
+}
+
+var nonPersistentTopicError = cmdutils.Output{
+	Desc: "the topic is not a persistent topic",
+	Out:  "[✖]  transactions are only supported on persistent topics, got '<topic-name>'",
+}
+
+func bufferStatsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the stats of the transaction buffer of a topic, the transaction buffer " +
+		"holds the messages produced in the ongoing transactions"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	stats := cmdutils.Example{
+		Desc:    "Get the stats of the transaction buffer of a topic",
+		Command: "pulsarctl transactions buffer-stats (topic)",
+	}
+	withLowWaterMarks := cmdutils.Example{
+		Desc:    "Get the stats of the transaction buffer of a topic with the low water marks",
+		Command: "pulsarctl transactions buffer-stats (topic) --low-water-marks",
+	}
+	examples = append(examples, stats, withLowWaterMarks)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"state\": \"Ready\",\n" +
+			"  \"maxReadPosition\": \"12:3\",\n" +
+			"  \"lastSnapshotTimestamps\": 1792411200000,\n" +
+			"  \"ongoingTxnSize\": 1,\n" +
+			"  \"recoverStartTime\": 1792411100000,\n" +
+			"  \"recoverEndTime\": 1792411100050,\n" +
+			"  \"totalAbortedTransactions\": 0\n" +
+			"}",
+	}
+	out = append(out, successOut, topicArgError, nonPersistentTopicError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"buffer-stats",
+		"Get the stats of the transaction buffer of a topic",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"buffer-stats")
+
+	var lowWaterMarks, segmentStats bool
+	vc.SetRunFuncWithNameArg(func() error {
+		return doBufferStats(vc, lowWaterMarks, segmentStats)
+	}, "the topic name is not specified or the topic name is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Buffer Stats", func(set *pflag.FlagSet) {
+		set.BoolVarP(&lowWaterMarks, "low-water-marks", "l", false,
+			"Include the low water marks of the transaction coordinators")
+		set.BoolVar(&segmentStats, "segment-stats", false,
+			"Include the stats of the aborted transaction segments")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doBufferStats(vc *cmdutils.VerbCmd, lowWaterMarks, segmentStats bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	parts, err := topicPathParts(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := newTransactionsClient()
+	var stats map[string]interface{}
+	_, err = rc.GetWithQueryParams(txnEndpoint(rc, append([]string{"transactionBufferStats"}, parts...)...),
+		&stats, map[string]string{
+			"lowWaterMarks": strconv.FormatBool(lowWaterMarks),
+			"segmentStats":  strconv.FormatBool(segmentStats),
+		}, true)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().WithObject(stats)
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,116 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func coordinatorInternalStatsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the internal stats of a transaction coordinator, " +
+		"which include the stats of the managed ledger storing the transaction log"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	stats := cmdutils.Example{
+		Desc:    "Get the internal stats of a transaction coordinator",
+		Command: "pulsarctl transactions coordinator-internal-stats (coordinator-id)",
+	}
+	metadata := cmdutils.Example{
+		Desc:    "Get the internal stats of a transaction coordinator with the ledger metadata",
+		Command: "pulsarctl transactions coordinator-internal-stats (coordinator-id) --metadata",
+	}
+	examples = append(examples, stats, metadata)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"transactionLogStats\": {\n" +
+			"    \"managedLedgerName\": \"pulsar/system/persistent/__transaction_log_0\",\n" +
+			"    \"managedLedgerInternalStats\": {\n" +
+			"      \"entriesAddedCounter\": 3,\n" +
+			"      \"numberOfEntries\": 3,\n" +
+			"      \"totalSize\": 297,\n" +
+			"      \"currentLedgerEntries\": 3,\n" +
+			"      \"currentLedgerSize\": 297,\n" +
+			"      \"state\": \"LedgerOpened\",\n" +
+			"      \"ledgers\": [],\n" +
+			"      \"cursors\": {}\n" +
+			"    }\n" +
+			"  }\n" +
+			"}",
+	}
+	argError := cmdutils.Output{
+		Desc: "the coordinator id is not specified or the coordinator id is specified more than one",
+		Out:  "[✖]  the coordinator id is not specified or the coordinator id is specified more than one",
+	}
+	invalidID := cmdutils.Output{
+		Desc: "the coordinator id is not a number",
+		Out:  "[✖]  invalid coordinator id '<coordinator-id>'",
+	}
+	out = append(out, successOut, argError, invalidID)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"coordinator-internal-stats",
+		"Get the internal stats of a transaction coordinator",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"coordinator-internal-stats")
+
+	var withMetadata bool
+	vc.SetRunFuncWithNameArg(func() error {
+		return doCoordinatorInternalStats(vc, withMetadata)
+	}, "the coordinator id is not specified or the coordinator id is specified more than one")
+
+	vc.FlagSetGroup.InFlagSet("Coordinator Internal Stats", func(set *pflag.FlagSet) {
+		set.BoolVarP(&withMetadata, "metadata", "m", false,
+			"Include the metadata of the ledgers")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doCoordinatorInternalStats(vc *cmdutils.VerbCmd, withMetadata bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	if _, err := strconv.Atoi(vc.NameArg); err != nil {
+		return errors.Errorf("invalid coordinator id '%s'", vc.NameArg)
+	}
+
+	rc := newTransactionsClient()
+	var stats map[string]interface{}
+	_, err := rc.GetWithQueryParams(txnEndpoint(rc, "coordinatorInternalStats", vc.NameArg), &stats,
+		map[string]string{"metadata": strconv.FormatBool(withMetadata)}, true)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().WithObject(stats)
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func coordinatorStatsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the stats of the transaction coordinators"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	allStats := cmdutils.Example{
+		Desc:    "Get the stats of all the transaction coordinators",
+		Command: "pulsarctl transactions coordinator-stats",
+	}
+	oneStats := cmdutils.Example{
+		Desc:    "Get the stats of a transaction coordinator",
+		Command: "pulsarctl transactions coordinator-stats --coordinator-id 0",
+	}
+	examples = append(examples, allStats, oneStats)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-------------+-------+--------------+----------------+----------------+\n" +
+			"| COORDINATOR | STATE | ONGOING TXNS | LOW WATER MARK | LEAST SIG BITS |\n" +
+			"+-------------+-------+--------------+----------------+----------------+\n" +
+			"|           0 | Ready |            2 |             11 |             13 |\n" +
+			"|           1 | Ready |            0 |              4 |              4 |\n" +
+			"+-------------+-------+--------------+----------------+----------------+",
+	}
+	notEnabledOut := cmdutils.Output{
+		Desc: "the transaction coordinator is not enabled",
+		Out:  "[✖]  code: 503 reason: This Broker is not configured with transactionCoordinatorEnabled=true.",
+	}
+	out = append(out, successOut, notEnabledOut)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"coordinator-stats",
+		"Get the stats of the transaction coordinators",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"coordinator-stats")
+
+	var coordinatorID int
+	vc.SetRunFunc(func() error {
+		return doCoordinatorStats(vc, coordinatorID)
+	})
+
+	vc.FlagSetGroup.InFlagSet("Coordinator Stats", func(set *pflag.FlagSet) {
+		set.IntVarP(&coordinatorID, "coordinator-id", "c", -1,
+			"The id of the transaction coordinator, all the coordinators are shown if not specified")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doCoordinatorStats(vc *cmdutils.VerbCmd, coordinatorID int) error {
+	rc := newTransactionsClient()
+	endpoint := txnEndpoint(rc, "coordinatorStats")
+
+	stats := make(map[int]TransactionCoordinatorStats)
+	if coordinatorID < 0 {
+		if _, err := rc.GetWithQueryParams(endpoint, &stats, nil, true); err != nil {
+			return err
+		}
+	} else {
+		var s TransactionCoordinatorStats
+		params := map[string]string{"coordinatorId": strconv.Itoa(coordinatorID)}
+		if _, err := rc.GetWithQueryParams(endpoint, &s, params, true); err != nil {
+			return err
+		}
+		stats[coordinatorID] = s
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(stats).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Coordinator", "State", "Ongoing Txns", "Low Water Mark", "Least Sig Bits"})
+			table.AppendBulk(coordinatorStatsRows(stats))
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+func coordinatorStatsRows(stats map[int]TransactionCoordinatorStats) [][]string {
+	ids := make([]int, 0, len(stats))
+	for id := range stats {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	rows := make([][]string, 0, len(ids))
+	for _, id := range ids {
+		s := stats[id]
+		rows = append(rows, []string{
+			strconv.Itoa(id),
+			s.State,
+			strconv.FormatInt(s.OngoingTxnSize, 10),
+			strconv.FormatInt(s.LowWaterMark, 10),
+			strconv.FormatInt(s.LeastSigBits, 10),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,117 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"strconv"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+var txnIDArgError = cmdutils.Output{
+	Desc: "the transaction id is not specified or the transaction id is specified more than one",
+	Out:  "[✖]  the transaction id is not specified or the transaction id is specified more than one",
+}
+
+var invalidTxnIDError = cmdutils.Output{
+	Desc: "the transaction id is not in the format of (<most-sig-bits>,<least-sig-bits>)",
+	Out: "[✖]  invalid transaction id '<txn-id>', it should be in the format of " +
+		"(<most-sig-bits>,<least-sig-bits>)",
+}
+
+var txnNotFoundError = cmdutils.Output{
+	Desc: "the transaction does not exist",
+	Out:  "[✖]  code: 404 reason: Transaction not found",
+}
+
+func metadataCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the metadata of a transaction, which includes the partitions the transaction " +
+		"produced to and the subscriptions it acknowledged messages on"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	metadata := cmdutils.Example{
+		Desc:    "Get the metadata of a transaction",
+		Command: "pulsarctl transactions metadata \"(0,3)\"",
+	}
+	examples = append(examples, metadata)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"txnId\": \"(0,3)\",\n" +
+			"  \"status\": \"OPEN\",\n" +
+			"  \"openTimestamp\": 1792411200000,\n" +
+			"  \"timeoutAt\": 1792411260000,\n" +
+			"  \"producedPartitions\": {\n" +
+			"    \"persistent://public/default/orders\": {\n" +
+			"      \"startPosition\": \"12:3\",\n" +
+			"      \"aborted\": false\n" +
+			"    }\n" +
+			"  },\n" +
+			"  \"ackedPartitions\": {\n" +
+			"    \"persistent://public/default/payments\": {\n" +
+			"      \"sub\": {\n" +
+			"        \"cumulativeAckPosition\": \"8:10\"\n" +
+			"      }\n" +
+			"    }\n" +
+			"  }\n" +
+			"}",
+	}
+	out = append(out, successOut, txnIDArgError, invalidTxnIDError, txnNotFoundError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"metadata",
+		"Get the metadata of a transaction",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"metadata")
+
+	vc.SetRunFuncWithNameArg(func() error {
+		return doMetadata(vc)
+	}, "the transaction id is not specified or the transaction id is specified more than one")
+
+	vc.EnableOutputFlagSet()
+}
+
+func doMetadata(vc *cmdutils.VerbCmd) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	id, err := parseTxnID(vc.NameArg)
+	if err != nil {
+		return err
+	}
+
+	rc := newTransactionsClient()
+	var metadata TransactionMetadata
+	err = rc.Get(txnEndpoint(rc, "transactionMetadata",
+		strconv.FormatInt(id.MostSigBits, 10), strconv.FormatInt(id.LeastSigBits, 10)), &metadata)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().WithObject(metadata)
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func pendingAckStatsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "Get the stats of the transaction pending ack of a subscription, the pending ack " +
+		"holds the acknowledgements made in the ongoing transactions"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	stats := cmdutils.Example{
+		Desc:    "Get the stats of the transaction pending ack of a subscription",
+		Command: "pulsarctl transactions pending-ack-stats (topic) (subscription)",
+	}
+	withLowWaterMarks := cmdutils.Example{
+		Desc:    "Get the stats of the transaction pending ack of a subscription with the low water marks",
+		Command: "pulsarctl transactions pending-ack-stats (topic) (subscription) --low-water-marks",
+	}
+	examples = append(examples, stats, withLowWaterMarks)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "{\n" +
+			"  \"state\": \"Ready\",\n" +
+			"  \"ongoingTxnSize\": 1,\n" +
+			"  \"recoverStartTime\": 1792411100000,\n" +
+			"  \"recoverEndTime\": 1792411100050\n" +
+			"}",
+	}
+	argsError := cmdutils.Output{
+		Desc: "the topic name or the subscription name is not specified",
+		Out:  "[✖]  need to specified the topic name and the subscription name",
+	}
+	out = append(out, successOut, argsError, nonPersistentTopicError)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"pending-ack-stats",
+		"Get the stats of the transaction pending ack of a subscription",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"pending-ack-stats")
+
+	var lowWaterMarks bool
+	vc.SetRunFuncWithMultiNameArgs(func() error {
+		return doPendingAckStats(vc, lowWaterMarks)
+	}, func(args []string) error {
+		if len(args) != 2 {
+			return errors.New("need to specified the topic name and the subscription name")
+		}
+		return nil
+	})
+
+	vc.FlagSetGroup.InFlagSet("Pending Ack Stats", func(set *pflag.FlagSet) {
+		set.BoolVarP(&lowWaterMarks, "low-water-marks", "l", false,
+			"Include the low water marks of the transaction coordinators")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doPendingAckStats(vc *cmdutils.VerbCmd, lowWaterMarks bool) error {
+	// for testing
+	if vc.NameError != nil {
+		return vc.NameError
+	}
+
+	parts, err := topicPathParts(vc.NameArgs[0])
+	if err != nil {
+		return err
+	}
+	parts = append(append([]string{"pendingAckStats"}, parts...), vc.NameArgs[1])
+
+	rc := newTransactionsClient()
+	var stats map[string]interface{}
+	_, err = rc.GetWithQueryParams(txnEndpoint(rc, parts...), &stats,
+		map[string]string{"lowWaterMarks": strconv.FormatBool(lowWaterMarks)}, true)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().WithObject(stats)
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+func slowTransactionsCmd(vc *cmdutils.VerbCmd) {
+	var desc cmdutils.LongDescription
+	desc.CommandUsedFor = "List the transactions which have been open for longer than the given timeout, " +
+		"grouped by the transaction coordinator"
+	desc.CommandPermission = "This command requires super-user permissions."
+
+	var examples []cmdutils.Example
+	slow := cmdutils.Example{
+		Desc:    "List the transactions which have been open for more than 5 minutes",
+		Command: "pulsarctl transactions slow-transactions",
+	}
+	withTimeout := cmdutils.Example{
+		Desc:    "List the transactions of a coordinator which have been open for more than 30 seconds",
+		Command: "pulsarctl transactions slow-transactions --timeout 30s --coordinator-id 0",
+	}
+	examples = append(examples, slow, withTimeout)
+	desc.CommandExamples = examples
+
+	var out []cmdutils.Output
+	successOut := cmdutils.Output{
+		Desc: "normal output",
+		Out: "+-------------+--------+------------+----------+----------------------" +
+			"+---------------------+---------------------+\n" +
+			"| COORDINATOR | TXN ID |   STATUS   | OPEN FOR |      TIMEOUT AT      " +
+			"| PRODUCED PARTITIONS | ACKED SUBSCRIPTIONS |\n" +
+			"+-------------+--------+------------+----------+----------------------" +
+			"+---------------------+---------------------+\n" +
+			"|           0 | (0,3)  | COMMITTING | 12m30s   | 2026-10-19T12:30:00Z " +
+			"|                   0 |                   0 |\n" +
+			"|             | (0,7)  | OPEN       | 6m0s     | 2026-10-19T12:40:00Z " +
+			"|                   1 |                   0 |\n" +
+			"|           1 | (1,2)  | OPEN       | 8m0s     | 2026-10-19T12:35:00Z " +
+			"|                   0 |                   2 |\n" +
+			"+-------------+--------+------------+----------+----------------------" +
+			"+---------------------+---------------------+",
+	}
+	invalidTimeout := cmdutils.Output{
+		Desc: "the timeout is invalid",
+		Out:  "[✖]  invalid timeout '<timeout>', it should be a positive duration such as 30s or 5m",
+	}
+	out = append(out, successOut, invalidTimeout)
+	desc.CommandOutput = out
+
+	vc.SetDescription(
+		"slow-transactions",
+		"List the transactions which have been open for longer than the given timeout",
+		desc.ToString(),
+		desc.ExampleToString(),
+		"slow-transactions")
+
+	var timeoutStr string
+	var coordinatorID int
+	vc.SetRunFunc(func() error {
+		return doSlowTransactions(vc, timeoutStr, coordinatorID)
+	})
+
+	vc.FlagSetGroup.InFlagSet("Slow Transactions", func(set *pflag.FlagSet) {
+		set.StringVarP(&timeoutStr, "timeout", "t", "5m",
+			"List the transactions which have been open for longer than the timeout")
+		set.IntVarP(&coordinatorID, "coordinator-id", "c", -1,
+			"The id of the transaction coordinator, all the coordinators are searched if not specified")
+	})
+	vc.EnableOutputFlagSet()
+}
+
+func doSlowTransactions(vc *cmdutils.VerbCmd, timeoutStr string, coordinatorID int) error {
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout <= 0 {
+		return errors.Errorf("invalid timeout '%s', it should be a positive duration such as 30s or 5m", timeoutStr)
+	}
+
+	rc := newTransactionsClient()
+	var params map[string]string
+	if coordinatorID >= 0 {
+		params = map[string]string{"coordinatorId": strconv.Itoa(coordinatorID)}
+	}
+	txns := make(map[string]TransactionMetadata)
+	_, err = rc.GetWithQueryParams(
+		txnEndpoint(rc, "slowTransactions", strconv.FormatInt(timeout.Milliseconds(), 10)), &txns, params, true)
+	if err != nil {
+		return err
+	}
+
+	oc := cmdutils.NewOutputContent().
+		WithObject(txns).
+		WithTextFunc(func(w io.Writer) error {
+			table := tablewriter.NewWriter(w)
+			table.SetHeader([]string{"Coordinator", "Txn ID", "Status", "Open For", "Timeout At",
+				"Produced Partitions", "Acked Subscriptions"})
+			table.AppendBulk(slowTransactionRows(txns, time.Now()))
+			table.Render()
+			return nil
+		})
+	return vc.OutputConfig.WriteOutput(vc.Command.OutOrStdout(), oc)
+}
+
+// slowTransactionRows sorts the transactions by the coordinator and then by the open time,
+// the coordinator is only shown on the first row of each coordinator
+func slowTransactionRows(txns map[string]TransactionMetadata, now time.Time) [][]string {
+	type txn struct {
+		id       TxnID
+		metadata TransactionMetadata
+	}
+	sorted := make([]txn, 0, len(txns))
+	for key, metadata := range txns {
+		id, err := parseTxnID(key)
+		if err != nil {
+			// keep the transaction even if the broker uses an unknown id format
+			id = TxnID{MostSigBits: -1}
+		}
+		sorted = append(sorted, txn{id: id, metadata: metadata})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].id.MostSigBits != sorted[j].id.MostSigBits {
+			return sorted[i].id.MostSigBits < sorted[j].id.MostSigBits
+		}
+		if sorted[i].metadata.OpenTimestamp != sorted[j].metadata.OpenTimestamp {
+			return sorted[i].metadata.OpenTimestamp < sorted[j].metadata.OpenTimestamp
+		}
+		return sorted[i].id.LeastSigBits < sorted[j].id.LeastSigBits
+	})
+
+	rows := make([][]string, 0, len(sorted))
+	for i, t := range sorted {
+		coordinator := ""
+		if i == 0 || sorted[i-1].id.MostSigBits != t.id.MostSigBits {
+			coordinator = strconv.FormatInt(t.id.MostSigBits, 10)
+		}
+		acked := 0
+		for _, subs := range t.metadata.AckedPartitions {
+			acked += len(subs)
+		}
+		txnID := t.metadata.TxnID
+		if txnID == "" {
+			txnID = t.id.String()
+		}
+		rows = append(rows, []string{
+			coordinator,
+			txnID,
+			t.metadata.Status,
+			now.Sub(time.UnixMilli(t.metadata.OpenTimestamp)).Truncate(time.Second).String(),
+			time.UnixMilli(t.metadata.TimeoutAt).UTC().Format(time.RFC3339),
+			strconv.Itoa(len(t.metadata.ProducedPartitions)),
+			strconv.Itoa(acked),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin/config"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+
+	"github.com/streamnative/pulsarctl/pkg/cmdutils"
+)
+
+// TxnID is the id of a transaction, the most significant bits are the id of the
+// transaction coordinator which owns the transaction
+type TxnID struct {
+	MostSigBits  int64
+	LeastSigBits int64
+}
+
+func (id TxnID) String() string {
+	return fmt.Sprintf("(%d,%d)", id.MostSigBits, id.LeastSigBits)
+}
+
+// parseTxnID parses a transaction id in the format of (most,least) or most,least,
+// which is the format the broker uses in the transaction stats
+func parseTxnID(s string) (TxnID, error) {
+	invalid := errors.Errorf("invalid transaction id '%s', it should be in the format of "+
+		"(<most-sig-bits>,<least-sig-bits>)", s)
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "("), ")"), ",")
+	if len(parts) != 2 {
+		return TxnID{}, invalid
+	}
+	most, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil {
+		return TxnID{}, invalid
+	}
+	least, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+	if err != nil {
+		return TxnID{}, invalid
+	}
+	return TxnID{MostSigBits: most, LeastSigBits: least}, nil
+}
+
+// TransactionCoordinatorStats is the stats of a transaction coordinator
+type TransactionCoordinatorStats struct {
+	State            string `json:"state"`
+	LeastSigBits     int64  `json:"leastSigBits"`
+	LowWaterMark     int64  `json:"lowWaterMark"`
+	OngoingTxnSize   int64  `json:"ongoingTxnSize"`
+	RecoverStartTime int64  `json:"recoverStartTime"`
+	RecoverEndTime   int64  `json:"recoverEndTime"`
+}
+
+// TransactionInBufferStats is the state of a transaction in the buffer of a topic
+type TransactionInBufferStats struct {
+	StartPosition string `json:"startPosition"`
+	Aborted       bool   `json:"aborted"`
+}
+
+// TransactionInPendingAckStats is the state of a transaction in the pending ack of a subscription
+type TransactionInPendingAckStats struct {
+	CumulativeAckPosition string `json:"cumulativeAckPosition"`
+}
+
+// TransactionMetadata is the metadata of a transaction, the acked partitions are keyed
+// by the topic and then by the subscription
+type TransactionMetadata struct {
+	TxnID              string                                             `json:"txnId"`
+	Status             string                                             `json:"status"`
+	OpenTimestamp      int64                                              `json:"openTimestamp"`
+	TimeoutAt          int64                                              `json:"timeoutAt"`
+	ProducedPartitions map[string]TransactionInBufferStats                `json:"producedPartitions"`
+	AckedPartitions    map[string]map[string]TransactionInPendingAckStats `json:"ackedPartitions"`
+}
+
+func newTransactionsClient() *cmdutils.RestClient {
+	return cmdutils.NewPulsarRestClientWithAPIVersion(config.V3)
+}
+
+func txnEndpoint(rc *cmdutils.RestClient, parts ...string) string {
+	return rc.Endpoint("/transactions", parts...)
+}
+
+// topicPathParts returns the path parts of a topic in the transactions endpoints,
+// which only serve persistent topics
+func topicPathParts(name string) ([]string, error) {
+	topic, err := utils.GetTopicName(name)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.IsPersistent() {
+		return nil, errors.Errorf("transactions are only supported on persistent topics, got '%s'", name)
+	}
+	return []string{topic.GetTenant(), topic.GetNamespace(), topic.GetLocalName()}, nil
+}
@@ -0,0 +1,107 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTxnID(t *testing.T) {
+	for _, s := range []string{"(1,5)", "1,5", " ( 1, 5 ) "} {
+		id, err := parseTxnID(s)
+		assert.Nil(t, err)
+		assert.Equal(t, TxnID{MostSigBits: 1, LeastSigBits: 5}, id)
+		assert.Equal(t, "(1,5)", id.String())
+	}
+
+	for _, s := range []string{"", "1", "(1,5,6)", "(a,5)", "(1,b)"} {
+		_, err := parseTxnID(s)
+		assert.NotNil(t, err)
+		assert.Equal(t, "invalid transaction id '"+s+"', it should be in the format of "+
+			"(<most-sig-bits>,<least-sig-bits>)", err.Error())
+	}
+}
+
+func TestCoordinatorStatsRows(t *testing.T) {
+	rows := coordinatorStatsRows(map[int]TransactionCoordinatorStats{
+		1: {State: "Ready", LowWaterMark: 4, LeastSigBits: 4},
+		0: {State: "Ready", OngoingTxnSize: 2, LowWaterMark: 11, LeastSigBits: 13},
+	})
+	assert.Equal(t, [][]string{
+		{"0", "Ready", "2", "11", "13"},
+		{"1", "Ready", "0", "4", "4"},
+	}, rows)
+}
+
+func TestSlowTransactionRows(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)
+	open := func(d time.Duration) int64 { return now.Add(-d).UnixMilli() }
+	txns := map[string]TransactionMetadata{
+		"(1,2)": {TxnID: "(1,2)", Status: "OPEN", OpenTimestamp: open(8 * time.Minute),
+			TimeoutAt: now.Add(5 * time.Minute).UnixMilli(),
+			AckedPartitions: map[string]map[string]TransactionInPendingAckStats{
+				"persistent://public/default/a": {"sub-1": {}, "sub-2": {}},
+			}},
+		"(0,7)": {TxnID: "(0,7)", Status: "OPEN", OpenTimestamp: open(6 * time.Minute),
+			TimeoutAt:          now.Add(10 * time.Minute).UnixMilli(),
+			ProducedPartitions: map[string]TransactionInBufferStats{"persistent://public/default/b": {}}},
+		"(0,3)": {TxnID: "(0,3)", Status: "COMMITTING", OpenTimestamp: open(12*time.Minute + 30*time.Second),
+			TimeoutAt: now.UnixMilli()},
+	}
+
+	rows := slowTransactionRows(txns, now)
+	assert.Equal(t, [][]string{
+		{"0", "(0,3)", "COMMITTING", "12m30s", "2026-10-19T12:30:00Z", "0", "0"},
+		{"", "(0,7)", "OPEN", "6m0s", "2026-10-19T12:40:00Z", "1", "0"},
+		{"1", "(1,2)", "OPEN", "8m0s", "2026-10-19T12:35:00Z", "0", "2"},
+	}, rows)
+}
+
+func TestTransactionsArgsError(t *testing.T) {
+	_, _, nameErr, _ := TestTransactionsCommands(metadataCmd, []string{"metadata"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "the transaction id is not specified or the transaction id is specified more than one",
+		nameErr.Error())
+
+	_, execErr, _, _ := TestTransactionsCommands(abortCmd, []string{"abort", "0:3"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid transaction id '0:3', it should be in the format of "+
+		"(<most-sig-bits>,<least-sig-bits>)", execErr.Error())
+
+	_, execErr, _, _ = TestTransactionsCommands(coordinatorInternalStatsCmd,
+		[]string{"coordinator-internal-stats", "first"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid coordinator id 'first'", execErr.Error())
+
+	_, execErr, _, _ = TestTransactionsCommands(slowTransactionsCmd, []string{"slow-transactions", "-t", "-1s"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "invalid timeout '-1s', it should be a positive duration such as 30s or 5m", execErr.Error())
+
+	_, execErr, _, _ = TestTransactionsCommands(bufferStatsCmd,
+		[]string{"buffer-stats", "non-persistent://public/default/test"})
+	assert.NotNil(t, execErr)
+	assert.Equal(t, "transactions are only supported on persistent topics, "+
+		"got 'non-persistent://public/default/test'", execErr.Error())
+
+	_, _, nameErr, _ = TestTransactionsCommands(pendingAckStatsCmd, []string{"pending-ack-stats", "test"})
+	assert.NotNil(t, nameErr)
+	assert.Equal(t, "need to specified the topic name and the subscription name", nameErr.Error())
+}
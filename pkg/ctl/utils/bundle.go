@@ -0,0 +1,43 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+var bundleRangePattern = regexp.MustCompile(`^0x[0-9a-fA-F]{8}_0x[0-9a-fA-F]{8}$`)
+
+// ParseBundleName parses a bundle name in the form of <tenant>/<namespace>/<bundle-range>,
+// such as public/default/0x00000000_0x40000000
+func ParseBundleName(s string) (*utils.NameSpaceName, string, error) {
+	i := strings.LastIndex(s, "/")
+	if i < 0 || !bundleRangePattern.MatchString(s[i+1:]) {
+		return nil, "", errors.Errorf("invalid bundle name %s, the bundle name should be in the form of "+
+			"<tenant>/<namespace>/<start-boundary>_<end-boundary>", s)
+	}
+	ns, err := utils.GetNamespaceName(s[:i])
+	if err != nil {
+		return nil, "", err
+	}
+	return ns, s[i+1:], nil
+}
@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBundleName(t *testing.T) {
+	ns, bundle, err := ParseBundleName("public/default/0x00000000_0x40000000")
+	assert.Nil(t, err)
+	assert.Equal(t, "public/default", ns.String())
+	assert.Equal(t, "0x00000000_0x40000000", bundle)
+
+	for _, s := range []string{"public/default", "0x00000000_0x40000000", "public/default/0x0_0x1"} {
+		_, _, err = ParseBundleName(s)
+		assert.EqualError(t, err, "invalid bundle name "+s+", the bundle name should be in the form of "+
+			"<tenant>/<namespace>/<start-boundary>_<end-boundary>")
+	}
+
+	_, _, err = ParseBundleName("public/0x00000000_0x40000000")
+	assert.NotNil(t, err)
+}
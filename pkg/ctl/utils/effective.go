@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+const (
+	PolicyLevelTopic     = "topic"
+	PolicyLevelNamespace = "namespace"
+	PolicyLevelBroker    = "broker"
+)
+
+// EffectivePolicy is the value of a policy at the topic, namespace and broker level.
+// A nil level value means the policy is not set at that level.
+type EffectivePolicy struct {
+	Policy    string      `json:"policy"`
+	Value     interface{} `json:"value"`
+	Source    string      `json:"source"`
+	Topic     interface{} `json:"topic,omitempty"`
+	Namespace interface{} `json:"namespace,omitempty"`
+	Broker    interface{} `json:"broker,omitempty"`
+}
+
+// Resolve sets the effective value and its source to the first level that has the
+// policy set, a topic level value overrides the namespace value which overrides the
+// broker default
+func (p *EffectivePolicy) Resolve() *EffectivePolicy {
+	p.Value, p.Source = nil, ""
+	switch {
+	case p.Topic != nil:
+		p.Value, p.Source = p.Topic, PolicyLevelTopic
+	case p.Namespace != nil:
+		p.Value, p.Source = p.Namespace, PolicyLevelNamespace
+	case p.Broker != nil:
+		p.Value, p.Source = p.Broker, PolicyLevelBroker
+	}
+	return p
+}
+
+// WriteEffectivePolicies writes the policies as a table, the topic column is
+// only written when withTopic is set
+func WriteEffectivePolicies(w io.Writer, policies []EffectivePolicy, withTopic bool) error {
+	table := tablewriter.NewWriter(w)
+	// the values mix numbers and strings, keep them aligned the same way
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	header := []string{"Policy", "Effective", "Source"}
+	if withTopic {
+		header = append(header, "Topic")
+	}
+	table.SetHeader(append(header, "Namespace", "Broker"))
+	for _, p := range policies {
+		row := []string{p.Policy, formatPolicyValue(p.Value), formatPolicyValue(p.Source)}
+		if withTopic {
+			row = append(row, formatPolicyValue(p.Topic))
+		}
+		table.Append(append(row, formatPolicyValue(p.Namespace), formatPolicyValue(p.Broker)))
+	}
+	table.Render()
+	return nil
+}
+
+func formatPolicyValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		if value == "" {
+			return "-"
+		}
+		return value
+	case bool, int, int32, int64, float64:
+		return fmt.Sprint(value)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,216 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+)
+
+// PolicyDefinition describes where a policy is stored at each level, an empty path
+// means the policy can not be set at that level
+type PolicyDefinition struct {
+	Name          string
+	TopicPath     string
+	NamespacePath string
+	// BrokerConfigs are the broker configurations used when the policy is not set,
+	// the broker value is a map when there are more than one
+	BrokerConfigs []string
+	// NamespaceUnset is the namespace value which means the policy is not set
+	NamespaceUnset interface{}
+}
+
+// PolicyDefinitions are the policies shown by the effective policies view
+var PolicyDefinitions = []PolicyDefinition{
+	{Name: "messageTTL", TopicPath: "messageTTL", NamespacePath: "messageTTL",
+		BrokerConfigs: []string{"ttlDurationDefaultInSeconds"}},
+	{Name: "retention", TopicPath: "retention", NamespacePath: "retention",
+		BrokerConfigs: []string{"defaultRetentionTimeInMinutes", "defaultRetentionSizeInMB"}},
+	{Name: "backlogQuota", TopicPath: "backlogQuotaMap", NamespacePath: "backlogQuotaMap",
+		BrokerConfigs: []string{"backlogQuotaDefaultLimitBytes", "backlogQuotaDefaultLimitSecond",
+			"backlogQuotaDefaultRetentionPolicy"}},
+	{Name: "publishRate", TopicPath: "publishRate", NamespacePath: "publishRate",
+		BrokerConfigs: []string{"maxPublishRatePerTopicInMessages", "maxPublishRatePerTopicInBytes"}},
+	{Name: "dispatchRate", TopicPath: "dispatchRate", NamespacePath: "dispatchRate",
+		BrokerConfigs: []string{"dispatchThrottlingRatePerTopicInMsg", "dispatchThrottlingRatePerTopicInByte"}},
+	{Name: "subscriptionDispatchRate", TopicPath: "subscriptionDispatchRate",
+		NamespacePath: "subscriptionDispatchRate",
+		BrokerConfigs: []string{"dispatchThrottlingRatePerSubscriptionInMsg",
+			"dispatchThrottlingRatePerSubscriptionInByte"}},
+	{Name: "replicatorDispatchRate", TopicPath: "replicatorDispatchRate", NamespacePath: "replicatorDispatchRate",
+		BrokerConfigs: []string{"dispatchThrottlingRatePerReplicatorInMsg",
+			"dispatchThrottlingRatePerReplicatorInByte"}},
+	{Name: "subscribeRate", TopicPath: "subscribeRate", NamespacePath: "subscribeRate",
+		BrokerConfigs: []string{"subscribeThrottlingRatePerConsumer", "subscribeRatePeriodPerConsumerInSecond"}},
+	{Name: "maxProducers", TopicPath: "maxProducers", NamespacePath: "maxProducersPerTopic",
+		BrokerConfigs: []string{"maxProducersPerTopic"}},
+	{Name: "maxConsumers", TopicPath: "maxConsumers", NamespacePath: "maxConsumersPerTopic",
+		BrokerConfigs: []string{"maxConsumersPerTopic"}},
+	{Name: "maxConsumersPerSubscription", TopicPath: "maxConsumersPerSubscription",
+		NamespacePath: "maxConsumersPerSubscription", BrokerConfigs: []string{"maxConsumersPerSubscription"}},
+	{Name: "maxSubscriptionsPerTopic", TopicPath: "maxSubscriptionsPerTopic",
+		NamespacePath: "maxSubscriptionsPerTopic", BrokerConfigs: []string{"maxSubscriptionsPerTopic"}},
+	{Name: "maxUnackedMessagesOnConsumer", TopicPath: "maxUnackedMessagesOnConsumer",
+		NamespacePath: "maxUnackedMessagesPerConsumer", BrokerConfigs: []string{"maxUnackedMessagesPerConsumer"}},
+	{Name: "maxUnackedMessagesOnSubscription", TopicPath: "maxUnackedMessagesOnSubscription",
+		NamespacePath: "maxUnackedMessagesPerSubscription",
+		BrokerConfigs: []string{"maxUnackedMessagesPerSubscription"}},
+	{Name: "maxMessageSize", TopicPath: "maxMessageSize", BrokerConfigs: []string{"maxMessageSize"}},
+	{Name: "deduplication", TopicPath: "deduplicationEnabled", NamespacePath: "deduplication",
+		BrokerConfigs: []string{"brokerDeduplicationEnabled"}},
+	{Name: "delayedDelivery", TopicPath: "delayedDelivery", NamespacePath: "delayedDelivery",
+		BrokerConfigs: []string{"delayedDeliveryEnabled", "delayedDeliveryTickTimeMillis"}},
+	{Name: "compactionThreshold", TopicPath: "compactionThreshold", NamespacePath: "compactionThreshold",
+		BrokerConfigs: []string{"brokerServiceCompactionThresholdInBytes"}},
+	{Name: "persistence", TopicPath: "persistence", NamespacePath: "persistence",
+		BrokerConfigs: []string{"managedLedgerDefaultEnsembleSize", "managedLedgerDefaultWriteQuorum",
+			"managedLedgerDefaultAckQuorum", "managedLedgerDefaultMarkDeleteRateLimit"}},
+	{Name: "inactiveTopicPolicies", TopicPath: "inactiveTopicPolicies", NamespacePath: "inactiveTopicPolicies",
+		BrokerConfigs: []string{"brokerDeleteInactiveTopicsEnabled",
+			"brokerDeleteInactiveTopicsMaxInactiveDurationSeconds", "brokerDeleteInactiveTopicsMode"}},
+	{Name: "offloadPolicies", TopicPath: "offloadPolicies", NamespacePath: "offloadPolicies",
+		BrokerConfigs: []string{"managedLedgerOffloadDriver", "managedLedgerOffloadAutoTriggerSizeThresholdBytes",
+			"managedLedgerOffloadThresholdInSeconds", "managedLedgerOffloadDeletionLagMs"}},
+	{Name: "schemaCompatibilityStrategy", TopicPath: "schemaCompatibilityStrategy",
+		NamespacePath: "schemaCompatibilityStrategy", BrokerConfigs: []string{"schemaCompatibilityStrategy"}},
+	// a disabled namespace flag falls back to the broker configuration
+	{Name: "schemaValidationEnforced", TopicPath: "schemaValidationEnforced",
+		NamespacePath: "schemaValidationEnforced", BrokerConfigs: []string{"schemaValidationEnforced"},
+		NamespaceUnset: false},
+}
+
+// FindPolicyDefinitions returns the definitions of the given policies
+func FindPolicyDefinitions(names ...string) []PolicyDefinition {
+	var defs []PolicyDefinition
+	for _, name := range names {
+		for _, def := range PolicyDefinitions {
+			if def.Name == name {
+				defs = append(defs, def)
+			}
+		}
+	}
+	return defs
+}
+
+// PolicyLevelGetter reads the value stored at a policy path of a level, nil means
+// the policy is not set
+type PolicyLevelGetter func(path string) (interface{}, error)
+
+// ResolveEffectivePolicies reads the policies at each level and resolves the effective
+// values, the topic level is skipped when topic is nil
+func ResolveEffectivePolicies(defs []PolicyDefinition, topic, namespace PolicyLevelGetter,
+	brokerConfig map[string]string) ([]EffectivePolicy, error) {
+	policies := make([]EffectivePolicy, 0, len(defs))
+	for _, def := range defs {
+		p := EffectivePolicy{Policy: def.Name}
+		if topic != nil && def.TopicPath != "" {
+			v, err := topic(def.TopicPath)
+			if err != nil {
+				return nil, err
+			}
+			if !isPolicyUnset(v) {
+				p.Topic = v
+			}
+		}
+		if namespace != nil && def.NamespacePath != "" {
+			v, err := namespace(def.NamespacePath)
+			if err != nil {
+				return nil, err
+			}
+			if !isPolicyUnset(v) && (def.NamespaceUnset == nil || !reflect.DeepEqual(v, def.NamespaceUnset)) {
+				p.Namespace = v
+			}
+		}
+		p.Broker = brokerPolicyValue(def.BrokerConfigs, brokerConfig)
+		policies = append(policies, *p.Resolve())
+	}
+	return policies, nil
+}
+
+// NewPolicyLevelGetter returns a getter which decodes the policy values read by get,
+// an empty value means the policy is not set
+func NewPolicyLevelGetter(get func(path string) ([]byte, error)) PolicyLevelGetter {
+	return func(path string) (interface{}, error) {
+		body, err := get(path)
+		if err != nil {
+			return nil, err
+		}
+		return decodePolicyValue(body)
+	}
+}
+
+func decodePolicyValue(body []byte) (interface{}, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, nil
+	}
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func isPolicyUnset(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == "" || value == string(utils.SchemaCompatibilityStrategyUndefined)
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+	return false
+}
+
+// brokerPolicyValue reads the broker configurations of a policy, numbers and booleans
+// are decoded so they are shown like the topic and namespace values
+func brokerPolicyValue(keys []string, config map[string]string) interface{} {
+	values := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if s, ok := config[key]; ok && s != "" {
+			values[key] = brokerConfigValue(s)
+		}
+	}
+	switch {
+	case len(values) == 0:
+		return nil
+	case len(keys) == 1:
+		return values[keys[0]]
+	}
+	return values
+}
+
+func brokerConfigValue(s string) interface{} {
+	if s == "true" || s == "false" {
+		return s == "true"
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return json.Number(s)
+	}
+	if s == string(utils.SchemaCompatibilityStrategyUndefined) {
+		return nil
+	}
+	return s
+}
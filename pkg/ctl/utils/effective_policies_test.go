@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func levelGetter(values map[string]string) PolicyLevelGetter {
+	return NewPolicyLevelGetter(func(path string) ([]byte, error) {
+		return []byte(values[path]), nil
+	})
+}
+
+func TestResolveEffectivePolicies(t *testing.T) {
+	topic := levelGetter(map[string]string{
+		"messageTTL":                  "3600",
+		"schemaCompatibilityStrategy": "\"UNDEFINED\"",
+		"backlogQuotaMap":             "{}",
+		"schemaValidationEnforced":    "false",
+	})
+	namespace := levelGetter(map[string]string{
+		"messageTTL":                  "60",
+		"retention":                   "{\"retentionTimeInMinutes\":10,\"retentionSizeInMB\":100}",
+		"schemaCompatibilityStrategy": "\"BACKWARD\"",
+		"schemaValidationEnforced":    "true",
+		"maxProducersPerTopic":        "null",
+	})
+	broker := map[string]string{
+		"ttlDurationDefaultInSeconds":        "0",
+		"backlogQuotaDefaultLimitBytes":      "-1",
+		"backlogQuotaDefaultLimitSecond":     "-1",
+		"backlogQuotaDefaultRetentionPolicy": "producer_request_hold",
+		"maxProducersPerTopic":               "0",
+		"schemaCompatibilityStrategy":        "FULL",
+		"schemaValidationEnforced":           "false",
+	}
+
+	policies, err := ResolveEffectivePolicies(FindPolicyDefinitions("messageTTL", "retention", "backlogQuota",
+		"maxProducers", "schemaCompatibilityStrategy", "schemaValidationEnforced", "maxMessageSize"),
+		topic, namespace, broker)
+	assert.Nil(t, err)
+	assert.Len(t, policies, 7)
+
+	assert.Equal(t, json.Number("3600"), policies[0].Value)
+	assert.Equal(t, PolicyLevelTopic, policies[0].Source)
+	assert.Equal(t, json.Number("60"), policies[0].Namespace)
+	assert.Equal(t, json.Number("0"), policies[0].Broker)
+
+	assert.Equal(t, PolicyLevelNamespace, policies[1].Source)
+	assert.Nil(t, policies[1].Topic)
+	assert.Nil(t, policies[1].Broker)
+
+	assert.Equal(t, PolicyLevelBroker, policies[2].Source)
+	assert.Equal(t, map[string]interface{}{
+		"backlogQuotaDefaultLimitBytes":      json.Number("-1"),
+		"backlogQuotaDefaultLimitSecond":     json.Number("-1"),
+		"backlogQuotaDefaultRetentionPolicy": "producer_request_hold",
+	}, policies[2].Value)
+
+	assert.Equal(t, PolicyLevelBroker, policies[3].Source)
+	assert.Equal(t, json.Number("0"), policies[3].Value)
+
+	assert.Equal(t, "BACKWARD", policies[4].Value)
+	assert.Equal(t, PolicyLevelNamespace, policies[4].Source)
+
+	assert.Equal(t, false, policies[5].Value)
+	assert.Equal(t, PolicyLevelTopic, policies[5].Source)
+
+	assert.Nil(t, policies[6].Value)
+	assert.Equal(t, "", policies[6].Source)
+}
+
+func TestResolveEffectivePoliciesWithoutTopic(t *testing.T) {
+	namespace := levelGetter(map[string]string{"schemaValidationEnforced": "false"})
+	broker := map[string]string{"schemaValidationEnforced": "true"}
+
+	policies, err := ResolveEffectivePolicies(FindPolicyDefinitions("schemaValidationEnforced"),
+		nil, namespace, broker)
+	assert.Nil(t, err)
+	assert.Equal(t, true, policies[0].Value)
+	assert.Equal(t, PolicyLevelBroker, policies[0].Source)
+	assert.Nil(t, policies[0].Namespace)
+}
+
+func TestPolicyDefinitions(t *testing.T) {
+	names := make(map[string]bool)
+	for _, def := range PolicyDefinitions {
+		assert.False(t, names[def.Name], def.Name)
+		names[def.Name] = true
+		assert.NotEmpty(t, def.BrokerConfigs, def.Name)
+	}
+}
@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectivePolicyResolve(t *testing.T) {
+	p := (&EffectivePolicy{Policy: "p", Namespace: 10, Broker: 20}).Resolve()
+	assert.Equal(t, 10, p.Value)
+	assert.Equal(t, PolicyLevelNamespace, p.Source)
+
+	p.Topic = 5
+	p.Resolve()
+	assert.Equal(t, 5, p.Value)
+	assert.Equal(t, PolicyLevelTopic, p.Source)
+
+	p = (&EffectivePolicy{Policy: "p"}).Resolve()
+	assert.Nil(t, p.Value)
+	assert.Equal(t, "", p.Source)
+}
+
+func TestWriteEffectivePolicies(t *testing.T) {
+	policies := []EffectivePolicy{
+		*(&EffectivePolicy{Policy: "schemaCompatibilityStrategy", Namespace: "FULL", Broker: "FULL"}).Resolve(),
+		*(&EffectivePolicy{Policy: "retention", Broker: map[string]int{"retentionSizeInMB": 0}}).Resolve(),
+	}
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteEffectivePolicies(&buf, policies, false))
+	assert.Equal(t,
+		"+-----------------------------+-------------------------+-----------+-----------+-------------------------+\n"+
+			"|           POLICY            |        EFFECTIVE        |  SOURCE   | NAMESPACE |         BROKER          |\n"+
+			"+-----------------------------+-------------------------+-----------+-----------+-------------------------+\n"+
+			"| schemaCompatibilityStrategy | FULL                    | namespace | FULL      | FULL                    |\n"+
+			"| retention                   | {\"retentionSizeInMB\":0} | broker    | -         | {\"retentionSizeInMB\":0} |\n"+
+			"+-----------------------------+-------------------------+-----------+-----------+-------------------------+\n",
+		buf.String())
+}
@@ -0,0 +1,157 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var offloadDrivers = []string{"S3", "aws-s3", "google-cloud-storage", "filesystem", "azureblob", "aliyun-oss"}
+
+var offloadedReadPriorities = map[string]string{
+	"bookkeeper-first":     "BOOKKEEPER_FIRST",
+	"tiered-storage-first": "TIERED_STORAGE_FIRST",
+}
+
+// OffloadPolicies is the offload policies of a namespace or a topic. The numeric fields
+// are always sent, because 0 is a meaningful threshold
+//
+//nolint:lll
+type OffloadPolicies struct {
+	ManagedLedgerOffloadDriver              string `json:"managedLedgerOffloadDriver"`
+	ManagedLedgerOffloadThresholdInBytes    int64  `json:"managedLedgerOffloadThresholdInBytes"`
+	ManagedLedgerOffloadThresholdInSeconds  int64  `json:"managedLedgerOffloadThresholdInSeconds"`
+	ManagedLedgerOffloadDeletionLagInMillis int64  `json:"managedLedgerOffloadDeletionLagInMillis"`
+	ManagedLedgerOffloadedReadPriority      string `json:"managedLedgerOffloadedReadPriority,omitempty"`
+	ManagedLedgerOffloadBucket              string `json:"managedLedgerOffloadBucket,omitempty"`
+	ManagedLedgerOffloadRegion              string `json:"managedLedgerOffloadRegion,omitempty"`
+	ManagedLedgerOffloadServiceEndpoint     string `json:"managedLedgerOffloadServiceEndpoint,omitempty"`
+	S3ManagedLedgerOffloadBucket            string `json:"s3ManagedLedgerOffloadBucket,omitempty"`
+	S3ManagedLedgerOffloadRegion            string `json:"s3ManagedLedgerOffloadRegion,omitempty"`
+	S3ManagedLedgerOffloadServiceEndpoint   string `json:"s3ManagedLedgerOffloadServiceEndpoint,omitempty"`
+	GcsManagedLedgerOffloadBucket           string `json:"gcsManagedLedgerOffloadBucket,omitempty"`
+	GcsManagedLedgerOffloadRegion           string `json:"gcsManagedLedgerOffloadRegion,omitempty"`
+}
+
+// OffloadPolicyFlags is the command line flags used to build the offload policies
+type OffloadPolicyFlags struct {
+	Driver             string
+	Bucket             string
+	Region             string
+	Endpoint           string
+	Threshold          string
+	ThresholdInSeconds string
+	DeletionLag        string
+	ReadPriority       string
+}
+
+func (f *OffloadPolicyFlags) AddTo(set *pflag.FlagSet) {
+	set.StringVarP(&f.Driver, "driver", "d", "",
+		"Offload driver, one of "+strings.Join(offloadDrivers, ", "))
+	set.StringVarP(&f.Bucket, "bucket", "b", "", "Bucket to place offloaded ledgers into")
+	set.StringVarP(&f.Region, "region", "r", "", "Region of the bucket to place offloaded ledgers into")
+	set.StringVarP(&f.Endpoint, "endpoint", "e", "", "Alternative endpoint to connect to")
+	set.StringVar(&f.Threshold, "threshold", "-1",
+		"Maximum number of bytes stored in the pulsar cluster for a topic before data will "+
+			"start being automatically offloaded to longterm storage (e.g. 10m, 16g, 3t, 100), "+
+			"negative values disable the size based offload")
+	set.StringVar(&f.ThresholdInSeconds, "threshold-seconds", "-1",
+		"Maximum age of the data stored in the pulsar cluster for a topic before data will "+
+			"start being automatically offloaded to longterm storage (e.g. 1h, 3d, 2w), "+
+			"-1 disables the time based offload")
+	set.StringVarP(&f.DeletionLag, "deletion-lag", "l", "4h",
+		"Duration to wait after offloading a ledger segment, before deleting the copy of that segment "+
+			"from cluster local storage (e.g. 1s, 1m, 1h)")
+	set.StringVar(&f.ReadPriority, "read-priority", "",
+		"Read priority of the offloaded data, one of bookkeeper-first, tiered-storage-first")
+	_ = cobra.MarkFlagRequired(set, "driver")
+}
+
+// Policies validates the flags and builds the offload policies, the bucket, region
+// and endpoint are placed into the fields used by the given driver
+func (f *OffloadPolicyFlags) Policies() (*OffloadPolicies, error) {
+	driver := ""
+	for _, d := range offloadDrivers {
+		if strings.EqualFold(d, f.Driver) {
+			driver = d
+			break
+		}
+	}
+	if driver == "" {
+		return nil, errors.Errorf("invalid offload driver '%s', valid drivers are %s",
+			f.Driver, strings.Join(offloadDrivers, ", "))
+	}
+
+	if f.Threshold == "" {
+		return nil, errors.New("offload threshold can not be empty")
+	}
+	threshold, err := ValidateSizeString(f.Threshold)
+	if err != nil {
+		return nil, errors.Errorf("invalid offload threshold '%s'", f.Threshold)
+	}
+
+	thresholdInSeconds, err := ParseRelativeTimeInSeconds(f.ThresholdInSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	lag, err := time.ParseDuration(f.DeletionLag)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := &OffloadPolicies{
+		ManagedLedgerOffloadDriver:              driver,
+		ManagedLedgerOffloadThresholdInBytes:    threshold,
+		ManagedLedgerOffloadThresholdInSeconds:  -1,
+		ManagedLedgerOffloadDeletionLagInMillis: lag.Milliseconds(),
+	}
+	if thresholdInSeconds >= 0 {
+		policies.ManagedLedgerOffloadThresholdInSeconds = int64(thresholdInSeconds / time.Second)
+	}
+
+	if f.ReadPriority != "" {
+		priority, ok := offloadedReadPriorities[strings.ToLower(f.ReadPriority)]
+		if !ok {
+			return nil, errors.Errorf("invalid offloaded read priority '%s', "+
+				"valid priorities are bookkeeper-first, tiered-storage-first", f.ReadPriority)
+		}
+		policies.ManagedLedgerOffloadedReadPriority = priority
+	}
+
+	switch driver {
+	case "S3", "aws-s3", "aliyun-oss":
+		policies.S3ManagedLedgerOffloadBucket = f.Bucket
+		policies.S3ManagedLedgerOffloadRegion = f.Region
+		policies.S3ManagedLedgerOffloadServiceEndpoint = f.Endpoint
+	case "google-cloud-storage":
+		policies.GcsManagedLedgerOffloadBucket = f.Bucket
+		policies.GcsManagedLedgerOffloadRegion = f.Region
+	default:
+		policies.ManagedLedgerOffloadBucket = f.Bucket
+		policies.ManagedLedgerOffloadRegion = f.Region
+		policies.ManagedLedgerOffloadServiceEndpoint = f.Endpoint
+	}
+
+	return policies, nil
+}
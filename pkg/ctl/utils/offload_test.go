@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffloadPolicyFlags(t *testing.T) {
+	f := &OffloadPolicyFlags{
+		Driver:             "aws-s3",
+		Bucket:             "bucket",
+		Region:             "us-west-2",
+		Endpoint:           "https://s3.us-west-2.amazonaws.com",
+		Threshold:          "10m",
+		ThresholdInSeconds: "1h",
+		DeletionLag:        "1m",
+		ReadPriority:       "tiered-storage-first",
+	}
+	policies, err := f.Policies()
+	assert.Nil(t, err)
+	assert.Equal(t, &OffloadPolicies{
+		ManagedLedgerOffloadDriver:              "aws-s3",
+		ManagedLedgerOffloadThresholdInBytes:    10 * 1024 * 1024,
+		ManagedLedgerOffloadThresholdInSeconds:  3600,
+		ManagedLedgerOffloadDeletionLagInMillis: 60000,
+		ManagedLedgerOffloadedReadPriority:      "TIERED_STORAGE_FIRST",
+		S3ManagedLedgerOffloadBucket:            "bucket",
+		S3ManagedLedgerOffloadRegion:            "us-west-2",
+		S3ManagedLedgerOffloadServiceEndpoint:   "https://s3.us-west-2.amazonaws.com",
+	}, policies)
+
+	f = &OffloadPolicyFlags{
+		Driver:             "Google-Cloud-Storage",
+		Bucket:             "bucket",
+		Region:             "europe-west3",
+		Threshold:          "-1",
+		ThresholdInSeconds: "-1",
+		DeletionLag:        "4h",
+	}
+	policies, err = f.Policies()
+	assert.Nil(t, err)
+	assert.Equal(t, "google-cloud-storage", policies.ManagedLedgerOffloadDriver)
+	assert.Equal(t, "bucket", policies.GcsManagedLedgerOffloadBucket)
+	assert.Equal(t, "europe-west3", policies.GcsManagedLedgerOffloadRegion)
+	assert.Equal(t, int64(-1), policies.ManagedLedgerOffloadThresholdInBytes)
+	assert.Equal(t, int64(-1), policies.ManagedLedgerOffloadThresholdInSeconds)
+	assert.Equal(t, int64(4*60*60*1000), policies.ManagedLedgerOffloadDeletionLagInMillis)
+	assert.Empty(t, policies.ManagedLedgerOffloadedReadPriority)
+}
+
+func TestOffloadPolicyFlagsError(t *testing.T) {
+	f := &OffloadPolicyFlags{Driver: "tape", Threshold: "-1", ThresholdInSeconds: "-1", DeletionLag: "4h"}
+	_, err := f.Policies()
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid offload driver 'tape', valid drivers are "+
+		"S3, aws-s3, google-cloud-storage, filesystem, azureblob, aliyun-oss", err.Error())
+
+	f = &OffloadPolicyFlags{Driver: "S3", Threshold: "-1", ThresholdInSeconds: "-1", DeletionLag: "4h",
+		ReadPriority: "memory-first"}
+	_, err = f.Policies()
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid offloaded read priority 'memory-first', "+
+		"valid priorities are bookkeeper-first, tiered-storage-first", err.Error())
+
+	f = &OffloadPolicyFlags{Driver: "S3", Threshold: "ten", ThresholdInSeconds: "-1", DeletionLag: "4h"}
+	_, err = f.Policies()
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid offload threshold 'ten'", err.Error())
+}
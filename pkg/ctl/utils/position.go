@@ -0,0 +1,94 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	Earliest = "earliest"
+	Latest   = "latest"
+)
+
+// minTimestampDigits is the number of digits of a unix timestamp in milliseconds since 2001,
+// a shorter bare number is more likely a relative time missing its unit
+const minTimestampDigits = 13
+
+// ParseTime parses an RFC3339 timestamp, a unix timestamp in milliseconds or a
+// duration relative to now such as "-1h", "30m" or "2d"
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if len(strings.TrimPrefix(s, "-")) < minTimestampDigits {
+			return time.Time{}, errors.Errorf("invalid time '%s', a unix timestamp in milliseconds should "+
+				"have at least %d digits, or specify the unit of a relative time such as -%sm",
+				s, minTimestampDigits, strings.TrimPrefix(s, "-"))
+		}
+		return time.UnixMilli(ms), nil
+	}
+
+	relative := strings.TrimPrefix(s, "-")
+	d, err := time.ParseDuration(relative)
+	if err != nil {
+		if d, err = ParseRelativeTimeInSeconds(relative); err != nil || d < 0 {
+			return time.Time{}, errors.Errorf("invalid time '%s', expected an RFC3339 timestamp, "+
+				"a unix timestamp in milliseconds or a relative time such as -1h", s)
+		}
+	}
+	return now.Add(-d), nil
+}
+
+// ParseMessageID parses a message id in the form of ledgerID:entryID[:partitionIndex[:batchIndex]]
+func ParseMessageID(s string) (pulsar.MessageID, error) {
+	id, err := utils.ParseMessageID(s)
+	if err != nil {
+		return nil, err
+	}
+	return pulsar.NewMessageID(id.LedgerID, id.EntryID, int32(id.BatchIndex), int32(id.PartitionIndex)), nil
+}
+
+// ParsePosition parses a position of a topic, which is either "earliest", "latest",
+// a message id or a time accepted by ParseTime
+func ParsePosition(s string, now time.Time) (pulsar.MessageID, time.Time, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case Earliest:
+		return pulsar.EarliestMessageID(), time.Time{}, nil
+	case Latest:
+		return pulsar.LatestMessageID(), time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return nil, t, nil
+	}
+	if strings.Contains(s, ":") {
+		id, err := ParseMessageID(s)
+		return id, time.Time{}, err
+	}
+	t, err := ParseTime(s, now)
+	return nil, t, err
+}
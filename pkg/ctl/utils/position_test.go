@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tm, err := ParseTime("2020-01-01T00:00:00Z", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), tm)
+
+	tm, err = ParseTime("1577934245000", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, tm.UTC())
+
+	tm, err = ParseTime("-1h", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.Add(-time.Hour), tm)
+
+	tm, err = ParseTime("30m", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.Add(-30*time.Minute), tm)
+
+	tm, err = ParseTime("-2d", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.Add(-48*time.Hour), tm)
+
+	_, err = ParseTime("yesterday", now)
+	assert.NotNil(t, err)
+}
+
+func TestParseTimeBareNumber(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	_, err := ParseTime("5", now)
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid time '5', a unix timestamp in milliseconds should have at least 13 digits, "+
+		"or specify the unit of a relative time such as -5m", err.Error())
+
+	// a unix timestamp in seconds
+	_, err = ParseTime("1577934245", now)
+	assert.NotNil(t, err)
+
+	_, err = ParseTime("-5", now)
+	assert.NotNil(t, err)
+}
+
+func TestParsePosition(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	id, tm, err := ParsePosition("earliest", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), id.LedgerID())
+	assert.True(t, tm.IsZero())
+
+	id, _, err = ParsePosition("10:2", now)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), id.LedgerID())
+	assert.Equal(t, int64(2), id.EntryID())
+	assert.Equal(t, int32(-1), id.BatchIdx())
+
+	id, tm, err = ParsePosition("2020-01-01T00:00:00Z", now)
+	assert.Nil(t, err)
+	assert.Nil(t, id)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), tm)
+
+	id, tm, err = ParsePosition("-1h", now)
+	assert.Nil(t, err)
+	assert.Nil(t, id)
+	assert.Equal(t, now.Add(-time.Hour), tm)
+
+	_, _, err = ParsePosition("10:x", now)
+	assert.NotNil(t, err)
+}
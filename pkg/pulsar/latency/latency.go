@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package latency records latencies in HDR histograms and summarizes them
+package latency
+
+import (
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+const (
+	// the latencies are recorded in microseconds, up to 10 minutes
+	minLatency     = 1
+	maxLatency     = int64(10 * time.Minute / time.Microsecond)
+	significantFig = 3
+)
+
+// Summary holds the latency percentiles in milliseconds
+type Summary struct {
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	P999 float64 `json:"p999"`
+	Max  float64 `json:"max"`
+}
+
+// NewHistogram returns a histogram of the latencies in microseconds
+func NewHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(minLatency, maxLatency, significantFig)
+}
+
+// Record records the latency in a histogram returned by NewHistogram
+func Record(h *hdrhistogram.Histogram, d time.Duration) {
+	us := d.Microseconds()
+	if us < minLatency {
+		us = minLatency
+	} else if us > maxLatency {
+		us = maxLatency
+	}
+	// the value is clamped to the trackable range, so it is always recorded
+	_ = h.RecordValue(us)
+}
+
+// Percentile returns the latency at the percentile in milliseconds
+func Percentile(h *hdrhistogram.Histogram, percentile float64) float64 {
+	return toMillis(h.ValueAtQuantile(percentile))
+}
+
+// Summarize returns the latency percentiles of the histogram
+func Summarize(h *hdrhistogram.Histogram) Summary {
+	return Summary{
+		Mean: h.Mean() / 1000,
+		P50:  Percentile(h, 50),
+		P95:  Percentile(h, 95),
+		P99:  Percentile(h, 99),
+		P999: Percentile(h, 99.9),
+		Max:  toMillis(h.Max()),
+	}
+}
+
+func toMillis(us int64) float64 {
+	return float64(us) / 1000
+}
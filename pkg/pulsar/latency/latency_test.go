@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package latency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	h := NewHistogram()
+	for i := 1; i <= 100; i++ {
+		Record(h, time.Duration(i)*time.Millisecond)
+	}
+
+	s := Summarize(h)
+	assert.InDelta(t, 50.5, s.Mean, 0.1)
+	assert.InDelta(t, 50, s.P50, 0.1)
+	assert.InDelta(t, 95, s.P95, 0.1)
+	assert.InDelta(t, 99, s.P99, 0.1)
+	assert.InDelta(t, 100, s.Max, 0.1)
+	assert.InDelta(t, 99, Percentile(h, 99), 0.1)
+}
+
+func TestRecordClamped(t *testing.T) {
+	h := NewHistogram()
+	Record(h, 0)
+	Record(h, time.Hour)
+	assert.Equal(t, int64(2), h.TotalCount())
+	assert.Equal(t, int64(1), h.Min())
+	assert.InDelta(t, float64(10*time.Minute/time.Millisecond), Summarize(h).Max, 1000)
+}
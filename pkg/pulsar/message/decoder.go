@@ -0,0 +1,264 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/hamba/avro/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// SchemaFetcher returns the schema registered with the given version, the latest
+// schema is returned if the version is nil
+type SchemaFetcher func(version *int64) (*utils.SchemaInfo, error)
+
+// AdminSchemaFetcher fetches the schemas of the given topic through the admin API
+func AdminSchemaFetcher(schemas admin.Schema, topic string) SchemaFetcher {
+	return func(version *int64) (*utils.SchemaInfo, error) {
+		if version == nil {
+			return schemas.GetSchemaInfo(topic)
+		}
+		return schemas.GetSchemaInfoByVersion(topic, *version)
+	}
+}
+
+// Decoder decodes the message payloads with the schema version they were published with,
+// the schemas are fetched once and cached
+type Decoder struct {
+	fetch  SchemaFetcher
+	codecs map[int64]codec
+	latest codec
+}
+
+func NewDecoder(fetch SchemaFetcher) *Decoder {
+	return &Decoder{
+		fetch:  fetch,
+		codecs: make(map[int64]codec),
+	}
+}
+
+// Decode sets the decoded value of the message payload, the error is kept
+// in the message if the payload can not be decoded
+func (d *Decoder) Decode(m *Message) {
+	c, err := d.codec(m.SchemaVersion)
+	if err == nil {
+		m.Value, err = c.decode(m.Payload)
+	}
+	if err != nil {
+		m.DecodeError = err.Error()
+	}
+}
+
+func (d *Decoder) codec(version *int64) (codec, error) {
+	if version == nil && d.latest != nil {
+		return d.latest, nil
+	}
+	if version != nil {
+		if c, ok := d.codecs[*version]; ok {
+			return c, nil
+		}
+	}
+
+	info, err := d.fetch(version)
+	if err != nil {
+		if !isNotFound(err) {
+			return nil, errors.Wrap(err, "failed to fetch the schema")
+		}
+		// the topic has no schema, the payload is kept as bytes
+		info = &utils.SchemaInfo{Type: "BYTES"}
+	}
+
+	c, err := newCodec(info)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		d.latest = c
+	} else {
+		d.codecs[*version] = c
+	}
+	return c, nil
+}
+
+func isNotFound(err error) bool {
+	var e rest.Error
+	return errors.As(err, &e) && e.Code == http.StatusNotFound
+}
+
+type codec interface {
+	decode(payload []byte) (interface{}, error)
+}
+
+type codecFunc func(payload []byte) (interface{}, error)
+
+func (f codecFunc) decode(payload []byte) (interface{}, error) {
+	return f(payload)
+}
+
+func newCodec(info *utils.SchemaInfo) (codec, error) {
+	schemaType := strings.ToUpper(info.Type)
+	switch schemaType {
+	case "", "NONE", "BYTES":
+		return codecFunc(func([]byte) (interface{}, error) {
+			return nil, nil
+		}), nil
+	case "AVRO":
+		schema, err := avro.Parse(string(info.Schema))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid avro schema")
+		}
+		return codecFunc(func(payload []byte) (interface{}, error) {
+			var v interface{}
+			err := avro.Unmarshal(schema, payload, &v)
+			return v, err
+		}), nil
+	case "JSON":
+		return codecFunc(func(payload []byte) (interface{}, error) {
+			var v interface{}
+			err := json.Unmarshal(payload, &v)
+			return v, err
+		}), nil
+	case "PROTOBUF_NATIVE":
+		return newProtobufNativeCodec(info.Schema)
+	}
+
+	if f, ok := primitiveDecoders[schemaType]; ok {
+		return f, nil
+	}
+	return nil, errors.Errorf("decoding %s schema is not supported", info.Type)
+}
+
+// protobufNativeSchema is the schema data of a PROTOBUF_NATIVE schema
+type protobufNativeSchema struct {
+	FileDescriptorSet      []byte `json:"fileDescriptorSet"`
+	RootMessageTypeName    string `json:"rootMessageTypeName"`
+	RootFileDescriptorName string `json:"rootFileDescriptorName"`
+}
+
+func newProtobufNativeCodec(data []byte) (codec, error) {
+	var schema protobufNativeSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, errors.Wrap(err, "invalid protobuf native schema")
+	}
+
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(schema.FileDescriptorSet, &fds); err != nil {
+		return nil, errors.Wrap(err, "invalid protobuf file descriptor set")
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid protobuf file descriptor set")
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(schema.RootMessageTypeName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "message type %s not found", schema.RootMessageTypeName)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a message type", schema.RootMessageTypeName)
+	}
+
+	return codecFunc(func(payload []byte) (interface{}, error) {
+		msg := dynamicpb.NewMessage(md)
+		if err := proto.Unmarshal(payload, msg); err != nil {
+			return nil, err
+		}
+		b, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(b), nil
+	}), nil
+}
+
+// primitiveDecoders decode the payloads of the primitive schemas, the numbers are encoded in big endian
+var primitiveDecoders = map[string]codecFunc{
+	"STRING": func(p []byte) (interface{}, error) {
+		return string(p), nil
+	},
+	"BOOLEAN": fixedSize(1, func(p []byte) interface{} {
+		return p[0] != 0
+	}),
+	"INT8": fixedSize(1, func(p []byte) interface{} {
+		return int8(p[0])
+	}),
+	"INT16": fixedSize(2, func(p []byte) interface{} {
+		return int16(binary.BigEndian.Uint16(p))
+	}),
+	"INT32": fixedSize(4, func(p []byte) interface{} {
+		return int32(binary.BigEndian.Uint32(p))
+	}),
+	"INT64": fixedSize(8, func(p []byte) interface{} {
+		return int64(binary.BigEndian.Uint64(p))
+	}),
+	"FLOAT": fixedSize(4, func(p []byte) interface{} {
+		return math.Float32frombits(binary.BigEndian.Uint32(p))
+	}),
+	"DOUBLE": fixedSize(8, func(p []byte) interface{} {
+		return math.Float64frombits(binary.BigEndian.Uint64(p))
+	}),
+	"DATE":      fixedSize(8, epochMillis),
+	"TIME":      fixedSize(8, epochMillis),
+	"TIMESTAMP": fixedSize(8, epochMillis),
+	"INSTANT": fixedSize(12, func(p []byte) interface{} {
+		return time.Unix(int64(binary.BigEndian.Uint64(p)), int64(int32(binary.BigEndian.Uint32(p[8:])))).UTC()
+	}),
+	"LOCAL_DATE": fixedSize(8, func(p []byte) interface{} {
+		return epochDay(int64(binary.BigEndian.Uint64(p))).Format("2006-01-02")
+	}),
+	"LOCAL_TIME": fixedSize(8, func(p []byte) interface{} {
+		return time.Time{}.Add(time.Duration(binary.BigEndian.Uint64(p))).Format("15:04:05.999999999")
+	}),
+	"LOCAL_DATE_TIME": fixedSize(16, func(p []byte) interface{} {
+		day := epochDay(int64(binary.BigEndian.Uint64(p)))
+		return day.Add(time.Duration(binary.BigEndian.Uint64(p[8:]))).Format("2006-01-02T15:04:05.999999999")
+	}),
+}
+
+func fixedSize(size int, f func([]byte) interface{}) codecFunc {
+	return func(p []byte) (interface{}, error) {
+		if len(p) != size {
+			return nil, fmt.Errorf("expected %d bytes but got %d", size, len(p))
+		}
+		return f(p), nil
+	}
+}
+
+func epochMillis(p []byte) interface{} {
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(p))).UTC()
+}
+
+func epochDay(days int64) time.Time {
+	return time.Unix(days*24*60*60, 0).UTC()
+}
@@ -0,0 +1,166 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/hamba/avro/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func fetcherOf(schemas map[int64]*utils.SchemaInfo) SchemaFetcher {
+	return func(version *int64) (*utils.SchemaInfo, error) {
+		v := int64(-1)
+		if version != nil {
+			v = *version
+		}
+		if s, ok := schemas[v]; ok {
+			return s, nil
+		}
+		return nil, rest.Error{Code: http.StatusNotFound, Reason: "Not Found"}
+	}
+}
+
+func TestDecodePrimitive(t *testing.T) {
+	i64 := make([]byte, 8)
+	binary.BigEndian.PutUint64(i64, uint64(math.Float64bits(1.5)))
+
+	tests := []struct {
+		schemaType string
+		payload    []byte
+		expected   interface{}
+	}{
+		{"STRING", []byte("hello"), "hello"},
+		{"BOOLEAN", []byte{1}, true},
+		{"INT8", []byte{0xff}, int8(-1)},
+		{"INT16", []byte{0x01, 0x00}, int16(256)},
+		{"INT32", []byte{0, 0, 0x01, 0x00}, int32(256)},
+		{"INT64", []byte{0, 0, 0, 0, 0, 0, 0x01, 0x00}, int64(256)},
+		{"DOUBLE", i64, 1.5},
+		{"TIMESTAMP", []byte{0, 0, 0, 0, 0, 0, 0x03, 0xe8}, time.Unix(1, 0).UTC()},
+		{"LOCAL_DATE", []byte{0, 0, 0, 0, 0, 0, 0, 0x01}, "1970-01-02"},
+		{"BYTES", []byte("raw"), nil},
+	}
+
+	for _, test := range tests {
+		d := NewDecoder(fetcherOf(map[int64]*utils.SchemaInfo{-1: {Type: test.schemaType}}))
+		m := &Message{Payload: test.payload}
+		d.Decode(m)
+		assert.Empty(t, m.DecodeError, test.schemaType)
+		assert.Equal(t, test.expected, m.Value, test.schemaType)
+	}
+
+	d := NewDecoder(fetcherOf(map[int64]*utils.SchemaInfo{-1: {Type: "INT32"}}))
+	m := &Message{Payload: []byte{1}}
+	d.Decode(m)
+	assert.Equal(t, "expected 4 bytes but got 1", m.DecodeError)
+}
+
+func TestDecodeWithoutSchema(t *testing.T) {
+	d := NewDecoder(fetcherOf(nil))
+	m := &Message{Payload: []byte("raw")}
+	d.Decode(m)
+	assert.Empty(t, m.DecodeError)
+	assert.Nil(t, m.Value)
+}
+
+func TestDecodeSchemaFetchError(t *testing.T) {
+	d := NewDecoder(func(*int64) (*utils.SchemaInfo, error) {
+		return nil, errors.New("connection refused")
+	})
+	m := &Message{Payload: []byte("raw")}
+	d.Decode(m)
+	assert.Contains(t, m.DecodeError, "connection refused")
+	assert.Nil(t, m.Value)
+}
+
+func TestDecodeBySchemaVersion(t *testing.T) {
+	avroSchema := `{"type":"record","name":"Test","fields":[{"name":"id","type":"int"},{"name":"name","type":"string"}]}`
+	schema, err := avro.Parse(avroSchema)
+	assert.Nil(t, err)
+	payload, err := avro.Marshal(schema, map[string]interface{}{"id": 1, "name": "pulsar"})
+	assert.Nil(t, err)
+
+	d := NewDecoder(fetcherOf(map[int64]*utils.SchemaInfo{
+		0: {Type: "JSON", Schema: []byte(avroSchema)},
+		1: {Type: "AVRO", Schema: []byte(avroSchema)},
+	}))
+
+	v0, v1 := int64(0), int64(1)
+	jsonMsg := &Message{SchemaVersion: &v0, Payload: []byte(`{"id":1,"name":"pulsar"}`)}
+	d.Decode(jsonMsg)
+	assert.Empty(t, jsonMsg.DecodeError)
+	assert.Equal(t, map[string]interface{}{"id": float64(1), "name": "pulsar"}, jsonMsg.Value)
+
+	avroMsg := &Message{SchemaVersion: &v1, Payload: payload}
+	d.Decode(avroMsg)
+	assert.Empty(t, avroMsg.DecodeError)
+	assert.Equal(t, map[string]interface{}{"id": 1, "name": "pulsar"}, avroMsg.Value)
+
+	unknown := int64(2)
+	missing := &Message{SchemaVersion: &unknown, Payload: []byte("raw")}
+	d.Decode(missing)
+	assert.Nil(t, missing.Value)
+}
+
+func TestDecodeProtobufNative(t *testing.T) {
+	fd := timestamppb.File_google_protobuf_timestamp_proto
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(fd)},
+	}
+	fdsBytes, err := proto.Marshal(fds)
+	assert.Nil(t, err)
+	schemaData, err := json.Marshal(protobufNativeSchema{
+		FileDescriptorSet:      fdsBytes,
+		RootMessageTypeName:    "google.protobuf.Timestamp",
+		RootFileDescriptorName: fd.Path(),
+	})
+	assert.Nil(t, err)
+
+	payload, err := proto.Marshal(&timestamppb.Timestamp{Seconds: 1})
+	assert.Nil(t, err)
+
+	d := NewDecoder(fetcherOf(map[int64]*utils.SchemaInfo{-1: {Type: "PROTOBUF_NATIVE", Schema: schemaData}}))
+	m := &Message{Payload: payload}
+	d.Decode(m)
+	assert.Empty(t, m.DecodeError)
+	b, err := json.Marshal(m.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, `"1970-01-01T00:00:01Z"`, strings.ReplaceAll(string(b), " ", ""))
+}
+
+func TestDecodeUnsupportedSchema(t *testing.T) {
+	d := NewDecoder(fetcherOf(map[int64]*utils.SchemaInfo{-1: {Type: "KEY_VALUE"}}))
+	m := &Message{Payload: []byte("raw")}
+	d.Decode(m)
+	assert.Equal(t, "decoding KEY_VALUE schema is not supported", m.DecodeError)
+}
@@ -0,0 +1,94 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Filter selects messages by key, properties and content, an empty criterion matches
+// every message
+type Filter struct {
+	Key        string
+	Properties map[string]string
+	Contains   string
+	JSONPath   *JSONPath
+}
+
+// Match reports whether the message matches all the criteria of the filter. The content
+// is matched against both the payload and the decoded value.
+func (f *Filter) Match(m *Message) bool {
+	if f.Key != "" && m.Key != f.Key {
+		return false
+	}
+
+	for k, v := range f.Properties {
+		if actual, ok := m.Properties[k]; !ok || actual != v {
+			return false
+		}
+	}
+
+	if f.Contains != "" && !bytes.Contains(m.Payload, []byte(f.Contains)) &&
+		!strings.Contains(valueText(m.Value), f.Contains) {
+		return false
+	}
+
+	if f.JSONPath != nil {
+		doc, ok := document(m)
+		if !ok || !f.JSONPath.Match(doc) {
+			return false
+		}
+	}
+	return true
+}
+
+func valueText(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
+
+// document returns the generic JSON form of the decoded value, or of the payload if
+// it is not decoded
+func document(m *Message) (interface{}, bool) {
+	data := m.Payload
+	if m.Value != nil {
+		b, err := json.Marshal(m.Value)
+		if err != nil {
+			return nil, false
+		}
+		data = b
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, false
+	}
+	return doc, true
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPath(t *testing.T) {
+	doc := map[string]interface{}{
+		"order": map[string]interface{}{"id": 1234.0, "ref": "A-1"},
+		"items": []interface{}{
+			map[string]interface{}{"sku": "x"},
+			map[string]interface{}{"sku": "y"},
+		},
+	}
+
+	for expr, want := range map[string]bool{
+		"$.order.id":           true,
+		".order.missing":       false,
+		"$.order.id == 1234":   true,
+		"$.order.id == '1234'": false,
+		`$.order.id == "1234"`: true,
+		"$.order.ref == A-1":   true,
+		"$.order.id != 1234":   false,
+		"$.items[1].sku == y":  true,
+		"$.items[-1].sku == y": true,
+		"$.items[*].sku == x":  true,
+		"$.items[2]":           false,
+		"$['order']['ref']":    true,
+		"$.*.ref == A-1":       true,
+		"$.order[0]":           false,
+	} {
+		p, err := ParseJSONPath(expr)
+		assert.Nil(t, err, expr)
+		assert.Equal(t, want, p.Match(doc), expr)
+	}
+
+	for _, expr := range []string{"$.", "$.items[1", "$.items[a]", "order"} {
+		_, err := ParseJSONPath(expr)
+		assert.NotNil(t, err, expr)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	m := &Message{
+		Key:        "order-1234",
+		Properties: map[string]string{"region": "eu"},
+		Payload:    []byte(`{"id":1234,"status":"paid"}`),
+	}
+
+	path, err := ParseJSONPath("$.id == 1234")
+	assert.Nil(t, err)
+
+	assert.True(t, (&Filter{}).Match(m))
+	assert.True(t, (&Filter{Key: "order-1234"}).Match(m))
+	assert.False(t, (&Filter{Key: "order-1"}).Match(m))
+	assert.True(t, (&Filter{Properties: map[string]string{"region": "eu"}}).Match(m))
+	assert.False(t, (&Filter{Properties: map[string]string{"region": "us"}}).Match(m))
+	assert.True(t, (&Filter{Contains: "paid"}).Match(m))
+	assert.False(t, (&Filter{Contains: "refunded"}).Match(m))
+	assert.True(t, (&Filter{JSONPath: path}).Match(m))
+
+	m.Payload = []byte{0x01, 0x02}
+	m.Value = map[string]interface{}{"id": 1234, "status": "refunded"}
+	assert.True(t, (&Filter{Contains: "refunded"}).Match(m))
+	assert.True(t, (&Filter{JSONPath: path}).Match(m))
+
+	m.Value = nil
+	assert.False(t, (&Filter{JSONPath: path}).Match(m))
+}
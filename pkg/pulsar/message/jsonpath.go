@@ -0,0 +1,187 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPath is a subset of JSONPath selecting the nodes of a JSON document by object
+// keys (.key or ['key']), array indexes ([n]) and wildcards (.* or [*]), optionally
+// compared with a JSON value using == or !=
+type JSONPath struct {
+	segments []pathSegment
+	op       string
+	value    interface{}
+}
+
+type pathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseJSONPath parses an expression such as $.order.id == 1234 or .items[*].sku
+func ParseJSONPath(expr string) (*JSONPath, error) {
+	p := &JSONPath{}
+	path := strings.TrimSpace(expr)
+
+	for _, op := range []string{"==", "!="} {
+		if i := strings.Index(path, op); i >= 0 {
+			p.op = op
+			literal := strings.TrimSpace(path[i+len(op):])
+			if err := json.Unmarshal([]byte(literal), &p.value); err != nil {
+				// a bare word is compared as a string
+				p.value = literal
+			}
+			path = strings.TrimSpace(path[:i])
+			break
+		}
+	}
+
+	path = strings.TrimPrefix(path, "$")
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			key := path[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid JSONPath '%s': empty key", expr)
+			}
+			p.segments = append(p.segments, pathSegment{key: key, wildcard: key == "*"})
+			path = path[end:]
+		case '[':
+			end := strings.Index(path, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath '%s': unclosed bracket", expr)
+			}
+			seg, err := parseBracket(path[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath '%s': %v", expr, err)
+			}
+			p.segments = append(p.segments, seg)
+			path = path[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSONPath '%s': unexpected '%c'", expr, path[0])
+		}
+	}
+	return p, nil
+}
+
+func parseBracket(s string) (pathSegment, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "*":
+		return pathSegment{wildcard: true}, nil
+	case len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0]:
+		return pathSegment{key: s[1 : len(s)-1]}, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return pathSegment{}, fmt.Errorf("invalid index '%s'", s)
+	}
+	return pathSegment{index: i, isIndex: true}, nil
+}
+
+// Match reports whether the path selects a node of the document, which equals (or not)
+// the value of the expression if it has one
+func (p *JSONPath) Match(doc interface{}) bool {
+	nodes := []interface{}{doc}
+	for _, seg := range p.segments {
+		var next []interface{}
+		for _, n := range nodes {
+			next = append(next, seg.selectFrom(n)...)
+		}
+		nodes = next
+	}
+
+	for _, n := range nodes {
+		switch p.op {
+		case "":
+			return true
+		case "==":
+			if jsonEqual(n, p.value) {
+				return true
+			}
+		case "!=":
+			if !jsonEqual(n, p.value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s pathSegment) selectFrom(n interface{}) []interface{} {
+	switch v := n.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			nodes := make([]interface{}, 0, len(v))
+			for _, child := range v {
+				nodes = append(nodes, child)
+			}
+			return nodes
+		}
+		if child, ok := v[s.key]; ok && !s.isIndex {
+			return []interface{}{child}
+		}
+	case []interface{}:
+		if s.wildcard {
+			return v
+		}
+		i := s.index
+		if i < 0 {
+			i += len(v)
+		}
+		if s.isIndex && i >= 0 && i < len(v) {
+			return []interface{}{v[i]}
+		}
+	}
+	return nil
+}
+
+func jsonEqual(a, b interface{}) bool {
+	// a number is also compared with its string form, ids are often strings
+	if s, ok := a.(string); ok {
+		if f, ok := b.(float64); ok {
+			return s == strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	if f, ok := a.(float64); ok {
+		if s, ok := b.(string); ok {
+			return s == strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	ab, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ab) == string(bb)
+}
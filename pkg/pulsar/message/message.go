@@ -0,0 +1,323 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	MessageIDHeader     = "X-Pulsar-Message-ID"
+	PublishTimeHeader   = "X-Pulsar-Publish-Time"
+	EventTimeHeader     = "X-Pulsar-Event-Time"
+	ProducerNameHeader  = "X-Pulsar-Producer-Name"
+	SequenceIDHeader    = "X-Pulsar-Sequence-Id"
+	PartitionKeyHeader  = "X-Pulsar-Partition-Key"
+	NumBatchHeader      = "X-Pulsar-Num-Batch-Message"
+	SchemaVersionHeader = "X-Pulsar-Schema-Version"
+
+	// SchemaVersionB64Header carries the raw schema version bytes encoded in base64
+	SchemaVersionB64Header = "X-Pulsar-Base64-Schema-Version-B64encoded"
+
+	// PropertyPrefix is part of the old protocol for message properties.
+	PropertyPrefix = "X-Pulsar-Property-"
+
+	// PropertyHeader is part of the new protocol introduced in PIP-279,
+	// the value is a JSON string representing the properties.
+	PropertyHeader = "X-Pulsar-Property"
+)
+
+// Message is a single message read from a topic along with its metadata
+type Message struct {
+	MessageID       string            `json:"messageId"`
+	Topic           string            `json:"topic,omitempty"`
+	BatchIndex      int               `json:"batchIndex"`
+	BatchSize       int               `json:"batchSize,omitempty"`
+	PublishTime     time.Time         `json:"publishTime"`
+	EventTime       *time.Time        `json:"eventTime,omitempty"`
+	Key             string            `json:"key,omitempty"`
+	ProducerName    string            `json:"producerName,omitempty"`
+	SequenceID      int64             `json:"sequenceId"`
+	SchemaVersion   *int64            `json:"schemaVersion,omitempty"`
+	RedeliveryCount *uint32           `json:"redeliveryCount,omitempty"`
+	Properties      map[string]string `json:"properties"`
+	Payload         []byte            `json:"payload,omitempty"`
+	Value           interface{}       `json:"value,omitempty"`
+	DecodeError     string            `json:"decodeError,omitempty"`
+}
+
+// FromResponse reads an entry returned by the admin REST API, a batched entry is
+// split into its individual messages
+func FromResponse(topic utils.TopicName, resp *http.Response) ([]*Message, error) {
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEntry(topic, resp.Header, payload)
+}
+
+// ParseEntry parses the headers and the payload of an entry returned by the admin REST API
+func ParseEntry(topic utils.TopicName, header http.Header, payload []byte) ([]*Message, error) {
+	id, err := utils.ParseMessageIDWithPartitionIndex(header.Get(MessageIDHeader), topic.GetPartitionIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Message{
+		Topic:        topic.String(),
+		ProducerName: header.Get(ProducerNameHeader),
+		Key:          header.Get(PartitionKeyHeader),
+		Properties:   make(map[string]string),
+	}
+	if t, ok := parseTime(header.Get(PublishTimeHeader)); ok {
+		entry.PublishTime = t
+	}
+	if t, ok := parseTime(header.Get(EventTimeHeader)); ok {
+		entry.EventTime = &t
+	}
+	if s := header.Get(SequenceIDHeader); s != "" {
+		if entry.SequenceID, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return nil, errors.Errorf("invalid sequence id '%s'", s)
+		}
+	}
+	if entry.SchemaVersion, err = parseSchemaVersion(header); err != nil {
+		return nil, err
+	}
+
+	for k := range header {
+		switch {
+		case k == PropertyHeader:
+			if err := json.Unmarshal([]byte(header.Get(k)), &entry.Properties); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(k, PropertyPrefix):
+			entry.Properties[strings.TrimPrefix(k, PropertyPrefix)] = header.Get(k)
+		}
+	}
+
+	batch := header.Get(NumBatchHeader)
+	if batch == "" {
+		entry.MessageID = id.String()
+		entry.Payload = payload
+		return []*Message{entry}, nil
+	}
+
+	size, err := strconv.Atoi(batch)
+	if err != nil {
+		return nil, errors.Errorf("invalid batch size '%s'", batch)
+	}
+	return splitBatch(entry, *id, size, payload)
+}
+
+func splitBatch(entry *Message, id utils.MessageID, size int, data []byte) ([]*Message, error) {
+	msgs := make([]*Message, 0, size)
+	buf32 := make([]byte, 4)
+	rd := bytes.NewReader(data)
+	for i := 0; i < size; i++ {
+		if _, err := io.ReadFull(rd, buf32); err != nil {
+			return nil, err
+		}
+		metaBuf := make([]byte, binary.BigEndian.Uint32(buf32))
+		if _, err := io.ReadFull(rd, metaBuf); err != nil {
+			return nil, err
+		}
+
+		m := *entry
+		m.Properties = make(map[string]string, len(entry.Properties))
+		for k, v := range entry.Properties {
+			m.Properties[k] = v
+		}
+		m.SequenceID = entry.SequenceID + int64(i)
+		m.BatchIndex = i
+		m.BatchSize = size
+
+		payloadSize, err := parseSingleMetadata(metaBuf, &m)
+		if err != nil {
+			return nil, err
+		}
+		m.Payload = make([]byte, payloadSize)
+		if _, err := io.ReadFull(rd, m.Payload); err != nil {
+			return nil, err
+		}
+
+		id.BatchIndex = i
+		m.MessageID = id.String()
+		msgs = append(msgs, &m)
+	}
+	return msgs, nil
+}
+
+// the field numbers of SingleMessageMetadata in PulsarApi.proto
+const (
+	singleMetaProperties   = 1
+	singleMetaPartitionKey = 2
+	singleMetaPayloadSize  = 3
+	singleMetaEventTime    = 5
+	singleMetaSequenceID   = 8
+)
+
+// parseSingleMetadata fills the message with the metadata of a single message in a batch and
+// returns the size of its payload
+func parseSingleMetadata(b []byte, m *Message) (int, error) {
+	payloadSize := 0
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == singleMetaProperties && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			key, value, err := parseKeyValue(v)
+			if err != nil {
+				return 0, err
+			}
+			m.Properties[key] = value
+			b = b[n:]
+		case num == singleMetaPartitionKey && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			m.Key = string(v)
+			b = b[n:]
+		case (num == singleMetaPayloadSize || num == singleMetaEventTime || num == singleMetaSequenceID) &&
+			typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			switch num {
+			case singleMetaPayloadSize:
+				payloadSize = int(int32(v))
+			case singleMetaEventTime:
+				if v > 0 {
+					t := time.UnixMilli(int64(v))
+					m.EventTime = &t
+				}
+			case singleMetaSequenceID:
+				m.SequenceID = int64(v)
+			}
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return 0, protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+
+	if payloadSize < 0 {
+		return 0, errors.Errorf("invalid payload size %d", payloadSize)
+	}
+	return payloadSize, nil
+}
+
+func parseKeyValue(b []byte) (string, string, error) {
+	var key, value string
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return "", "", protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return "", "", protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return "", "", protowire.ParseError(n)
+		}
+		switch num {
+		case 1:
+			key = string(v)
+		case 2:
+			value = string(v)
+		}
+		b = b[n:]
+	}
+	return key, value, nil
+}
+
+func parseTime(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, true
+	}
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil && ms > 0 {
+		return time.UnixMilli(ms), true
+	}
+	return time.Time{}, false
+}
+
+func parseSchemaVersion(header http.Header) (*int64, error) {
+	if s := header.Get(SchemaVersionHeader); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid schema version '%s'", s)
+		}
+		return &v, nil
+	}
+	if s := header.Get(SchemaVersionB64Header); s != "" {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, errors.Errorf("invalid schema version '%s'", s)
+		}
+		return SchemaVersionFromBytes(b)
+	}
+	return nil, nil
+}
+
+// SchemaVersionFromBytes converts the schema version carried by a message to the version number
+// used by the schema registry
+func SchemaVersionFromBytes(b []byte) (*int64, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	if len(b) != 8 {
+		return nil, fmt.Errorf("invalid schema version %x", b)
+	}
+	v := int64(binary.BigEndian.Uint64(b))
+	return &v, nil
+}
@@ -0,0 +1,130 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/binary"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestParseEntry(t *testing.T) {
+	topic, err := utils.GetTopicName("test-parse-entry")
+	assert.Nil(t, err)
+
+	header := http.Header{}
+	header.Set(MessageIDHeader, "10:2")
+	header.Set("X-Pulsar-publish-time", "2020-01-02T03:04:05.678Z")
+	header.Set("X-Pulsar-event-time", "2020-01-02T03:04:05Z")
+	header.Set("X-Pulsar-producer-name", "standalone-0-1")
+	header.Set("X-Pulsar-sequence-id", "7")
+	header.Set("X-Pulsar-schema-version", "2")
+	header.Set("X-Pulsar-Property-a", "b")
+
+	msgs, err := ParseEntry(*topic, header, []byte("hello"))
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 1)
+
+	m := msgs[0]
+	assert.Equal(t, "10:2:-1:-1", m.MessageID)
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 678000000, time.UTC), m.PublishTime.UTC())
+	assert.NotNil(t, m.EventTime)
+	assert.Equal(t, "standalone-0-1", m.ProducerName)
+	assert.Equal(t, int64(7), m.SequenceID)
+	assert.Equal(t, int64(2), *m.SchemaVersion)
+	assert.Equal(t, map[string]string{"A": "b"}, m.Properties)
+	assert.Equal(t, []byte("hello"), m.Payload)
+}
+
+func TestParseBatchEntry(t *testing.T) {
+	topic, err := utils.GetTopicName("test-parse-batch-entry-partition-1")
+	assert.Nil(t, err)
+
+	header := http.Header{}
+	header.Set(MessageIDHeader, "10:3")
+	header.Set("X-Pulsar-sequence-id", "5")
+	header.Set("X-Pulsar-num-batch-message", "2")
+
+	var payload []byte
+	payload = appendSingleMessage(payload, "k1", map[string]string{"p": "1"}, 0, []byte("first"))
+	payload = appendSingleMessage(payload, "k2", map[string]string{"p": "2"}, 1577934245000, []byte("second"))
+
+	msgs, err := ParseEntry(*topic, header, payload)
+	assert.Nil(t, err)
+	assert.Len(t, msgs, 2)
+
+	assert.Equal(t, "10:3:1:0", msgs[0].MessageID)
+	assert.Equal(t, 0, msgs[0].BatchIndex)
+	assert.Equal(t, 2, msgs[0].BatchSize)
+	assert.Equal(t, "k1", msgs[0].Key)
+	assert.Equal(t, int64(5), msgs[0].SequenceID)
+	assert.Nil(t, msgs[0].EventTime)
+	assert.Equal(t, map[string]string{"p": "1"}, msgs[0].Properties)
+	assert.Equal(t, []byte("first"), msgs[0].Payload)
+
+	assert.Equal(t, "10:3:1:1", msgs[1].MessageID)
+	assert.Equal(t, 1, msgs[1].BatchIndex)
+	assert.Equal(t, "k2", msgs[1].Key)
+	assert.Equal(t, int64(6), msgs[1].SequenceID)
+	assert.Equal(t, int64(1577934245000), msgs[1].EventTime.UnixMilli())
+	assert.Equal(t, map[string]string{"p": "2"}, msgs[1].Properties)
+	assert.Equal(t, []byte("second"), msgs[1].Payload)
+}
+
+func TestParseTruncatedBatchEntry(t *testing.T) {
+	topic, err := utils.GetTopicName("test-parse-truncated-batch-entry")
+	assert.Nil(t, err)
+
+	header := http.Header{}
+	header.Set(MessageIDHeader, "10:3")
+	header.Set("X-Pulsar-num-batch-message", "2")
+
+	payload := appendSingleMessage(nil, "k1", nil, 0, []byte("first"))
+	_, err = ParseEntry(*topic, header, payload)
+	assert.NotNil(t, err)
+}
+
+func appendSingleMessage(b []byte, key string, props map[string]string, eventTime uint64, payload []byte) []byte {
+	var meta []byte
+	for k, v := range props {
+		var kv []byte
+		kv = protowire.AppendTag(kv, 1, protowire.BytesType)
+		kv = protowire.AppendString(kv, k)
+		kv = protowire.AppendTag(kv, 2, protowire.BytesType)
+		kv = protowire.AppendString(kv, v)
+		meta = protowire.AppendTag(meta, singleMetaProperties, protowire.BytesType)
+		meta = protowire.AppendBytes(meta, kv)
+	}
+	meta = protowire.AppendTag(meta, singleMetaPartitionKey, protowire.BytesType)
+	meta = protowire.AppendString(meta, key)
+	meta = protowire.AppendTag(meta, singleMetaPayloadSize, protowire.VarintType)
+	meta = protowire.AppendVarint(meta, uint64(len(payload)))
+	if eventTime > 0 {
+		meta = protowire.AppendTag(meta, singleMetaEventTime, protowire.VarintType)
+		meta = protowire.AppendVarint(meta, eventTime)
+	}
+
+	b = binary.BigEndian.AppendUint32(b, uint32(len(meta)))
+	b = append(b, meta...)
+	return append(b, payload...)
+}
@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Separator separates the messages written in text
+const Separator = "-------------------------------------------------------------------------"
+
+// WriteText writes the messages in a human readable form, the payload is
+// dumped in hex if it is not decoded
+func WriteText(w io.Writer, msgs []*Message) error {
+	var sb strings.Builder
+	for i, m := range msgs {
+		if i != 0 {
+			sb.WriteString(Separator + "\n")
+		}
+		writeMessage(&sb, m)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMessage(sb *strings.Builder, m *Message) {
+	field := func(name string, value interface{}) {
+		fmt.Fprintf(sb, "%-17s: %v\n", name, value)
+	}
+
+	field("Message ID", m.MessageID)
+	if m.BatchSize > 0 {
+		field("Batch Index", fmt.Sprintf("%d/%d", m.BatchIndex, m.BatchSize))
+	}
+	if !m.PublishTime.IsZero() {
+		field("Publish Time", m.PublishTime.Format(time.RFC3339Nano))
+	}
+	if m.EventTime != nil {
+		field("Event Time", m.EventTime.Format(time.RFC3339Nano))
+	}
+	if m.Key != "" {
+		field("Key", m.Key)
+	}
+	if m.ProducerName != "" {
+		field("Producer", m.ProducerName)
+	}
+	field("Sequence ID", m.SequenceID)
+	if m.SchemaVersion != nil {
+		field("Schema Version", *m.SchemaVersion)
+	}
+	if m.RedeliveryCount != nil {
+		field("Redelivery Count", *m.RedeliveryCount)
+	}
+	p, _ := json.MarshalIndent(m.Properties, "", "    ")
+	field("Properties", string(p))
+	if m.DecodeError != "" {
+		field("Decode Error", m.DecodeError)
+	}
+
+	sb.WriteString("Message :\n")
+	switch v := m.Value.(type) {
+	case nil:
+		sb.WriteString(hex.Dump(m.Payload))
+	case string:
+		sb.WriteString(v + "\n")
+	default:
+		b, err := json.MarshalIndent(v, "", "    ")
+		if err != nil {
+			fmt.Fprintf(sb, "%v\n", v)
+		} else {
+			sb.Write(b)
+			sb.WriteString("\n")
+		}
+	}
+}
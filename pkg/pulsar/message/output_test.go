@@ -0,0 +1,61 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteText(t *testing.T) {
+	version := int64(1)
+	msgs := []*Message{
+		{
+			MessageID:  "10:2:-1:0",
+			BatchIndex: 0,
+			BatchSize:  2,
+			Key:        "key",
+			Properties: map[string]string{},
+			Payload:    []byte("raw"),
+		},
+		{
+			MessageID:     "10:2:-1:1",
+			BatchIndex:    1,
+			BatchSize:     2,
+			SchemaVersion: &version,
+			Properties:    map[string]string{},
+			Payload:       []byte(`{"id":1}`),
+			Value:         map[string]interface{}{"id": 1},
+		},
+	}
+
+	sb := &strings.Builder{}
+	assert.Nil(t, WriteText(sb, msgs))
+	out := sb.String()
+
+	assert.Contains(t, out, "Message ID       : 10:2:-1:0\n")
+	assert.Contains(t, out, "Batch Index      : 1/2\n")
+	assert.Contains(t, out, "Key              : key\n")
+	assert.Contains(t, out, "Schema Version   : 1\n")
+	assert.Contains(t, out, hex.Dump([]byte("raw")))
+	assert.Contains(t, out, "{\n    \"id\": 1\n}\n")
+	assert.Equal(t, 1, strings.Count(out, Separator))
+}
@@ -0,0 +1,148 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package message
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+)
+
+// FromPulsarMessage converts a message received by the messaging client
+func FromPulsarMessage(msg pulsar.Message) *Message {
+	id := msg.ID()
+	redeliveryCount := msg.RedeliveryCount()
+	m := &Message{
+		MessageID: utils.MessageID{
+			LedgerID:       id.LedgerID(),
+			EntryID:        id.EntryID(),
+			PartitionIndex: int(id.PartitionIdx()),
+			BatchIndex:     int(id.BatchIdx()),
+		}.String(),
+		Topic:           msg.Topic(),
+		PublishTime:     msg.PublishTime(),
+		Key:             msg.Key(),
+		ProducerName:    msg.ProducerName(),
+		RedeliveryCount: &redeliveryCount,
+		Properties:      make(map[string]string, len(msg.Properties())),
+		Payload:         msg.Payload(),
+	}
+	if id.BatchIdx() >= 0 && id.BatchSize() > 0 {
+		m.BatchIndex = int(id.BatchIdx())
+		m.BatchSize = int(id.BatchSize())
+	}
+	if t := msg.EventTime(); !t.IsZero() {
+		m.EventTime = &t
+	}
+	for k, v := range msg.Properties() {
+		m.Properties[k] = v
+	}
+	// an invalid schema version is left empty, the payload is not decoded then
+	m.SchemaVersion, _ = SchemaVersionFromBytes(msg.SchemaVersion())
+	return m
+}
+
+// Range bounds the messages read from a topic, a nil message id and a zero time leave
+// the bound open. Both bounds are inclusive.
+type Range struct {
+	FromID   pulsar.MessageID
+	FromTime time.Time
+	ToID     pulsar.MessageID
+	ToTime   time.Time
+}
+
+// ReadRange reads the messages of a non-partitioned topic, or of a single partition, within
+// the range and calls fn with each of them. The reading stops at the last message published
+// before the reading started or once fn returns false.
+func ReadRange(ctx context.Context, client pulsar.Client, topic string, r Range,
+	fn func(pulsar.Message) (bool, error)) error {
+	start := r.FromID
+	if start == nil {
+		start = pulsar.EarliestMessageID()
+	}
+
+	reader, err := client.CreateReader(pulsar.ReaderOptions{
+		Topic:                   topic,
+		StartMessageID:          start,
+		StartMessageIDInclusive: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if !r.FromTime.IsZero() {
+		if err := reader.SeekByTime(r.FromTime); err != nil {
+			return err
+		}
+	}
+
+	last, err := reader.GetLastMessageID()
+	if err != nil {
+		return err
+	}
+
+	for reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		id := msg.ID()
+		if compareEntry(id, last) > 0 ||
+			(r.ToID != nil && CompareMessageID(id, r.ToID) > 0) ||
+			(!r.ToTime.IsZero() && msg.PublishTime().After(r.ToTime)) {
+			return nil
+		}
+
+		next, err := fn(msg)
+		if err != nil || !next {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompareMessageID compares the position of two message ids of the same topic, the batch
+// index is ignored if either of them is not a batched message id
+func CompareMessageID(a, b pulsar.MessageID) int {
+	if c := compareEntry(a, b); c != 0 || a.BatchIdx() < 0 || b.BatchIdx() < 0 {
+		return c
+	}
+	return compareInt64(int64(a.BatchIdx()), int64(b.BatchIdx()))
+}
+
+func compareEntry(a, b pulsar.MessageID) int {
+	if c := compareInt64(a.LedgerID(), b.LedgerID()); c != 0 {
+		return c
+	}
+	return compareInt64(a.EntryID(), b.EntryID())
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
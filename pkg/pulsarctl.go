@@ -29,13 +29,16 @@ import (
 	"github.com/streamnative/pulsarctl/pkg/ctl/namespace"
 	"github.com/streamnative/pulsarctl/pkg/ctl/nsisolationpolicy"
 	"github.com/streamnative/pulsarctl/pkg/ctl/packages"
+	"github.com/streamnative/pulsarctl/pkg/ctl/perf"
 	"github.com/streamnative/pulsarctl/pkg/ctl/plugin"
+	"github.com/streamnative/pulsarctl/pkg/ctl/resourcegroups"
 	"github.com/streamnative/pulsarctl/pkg/ctl/resourcequotas"
 	"github.com/streamnative/pulsarctl/pkg/ctl/status"
 	"github.com/streamnative/pulsarctl/pkg/ctl/subscription"
 	"github.com/streamnative/pulsarctl/pkg/ctl/tenant"
 	"github.com/streamnative/pulsarctl/pkg/ctl/token"
 	"github.com/streamnative/pulsarctl/pkg/ctl/topic"
+	"github.com/streamnative/pulsarctl/pkg/ctl/transactions"
 	"github.com/streamnative/pulsarctl/pkg/oauth2"
 
 	function "github.com/streamnative/pulsarctl/pkg/ctl/functions"
@@ -126,11 +129,14 @@ func NewPulsarctlCmd() *cobra.Command {
 	rootCmd.AddCommand(brokers.Command(flagGrouping))
 	rootCmd.AddCommand(brokerstats.Command(flagGrouping))
 	rootCmd.AddCommand(resourcequotas.Command(flagGrouping))
+	rootCmd.AddCommand(resourcegroups.Command(flagGrouping))
 	rootCmd.AddCommand(functionsworker.Command(flagGrouping))
 	rootCmd.AddCommand(token.Command(flagGrouping))
 	rootCmd.AddCommand(context.Command(flagGrouping))
 	rootCmd.AddCommand(packages.Command(flagGrouping))
 	rootCmd.AddCommand(status.Command(flagGrouping))
+	rootCmd.AddCommand(perf.Command(flagGrouping))
+	rootCmd.AddCommand(transactions.Command(flagGrouping))
 
 	// bookkeeper related commands
 	rootCmd.AddCommand(bkctl.Command(flagGrouping))